@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+// Common English abbreviations
+var AbbreviationsEnglish = []string{
+	"mr", "mrs", "ms", "dr", "prof", "sr", "jr", "st", "mt", "rev", "hon",
+	"gen", "col", "capt", "lt", "sgt", "gov", "sen", "rep", "pres",
+	"e.g", "i.e", "etc", "vs", "cf", "al", "approx", "dept", "est", "fig",
+	"inc", "ltd", "co", "corp", "no", "vol", "pp", "ed", "eds",
+	"jan", "feb", "mar", "apr", "jun", "jul", "aug", "sep", "sept", "oct", "nov", "dec",
+	"mon", "tue", "wed", "thu", "fri", "sat", "sun",
+	"a.m", "p.m", "u.s", "u.k", "ph.d", "b.a", "m.a",
+}
+
+// Common German abbreviations
+var AbbreviationsGerman = []string{
+	"hr", "fr", "dr", "prof", "z.b", "d.h", "u.a", "usw", "bzw", "ca", "vgl",
+	"evtl", "ggf", "inkl", "max", "min", "nr", "s", "str", "tel", "u.s.w",
+	"z.t", "o.ä", "u.ä", "bzgl", "gem", "jh", "mio", "mrd", "abs", "abt",
+	"jan", "feb", "apr", "aug", "sept", "okt", "nov", "dez",
+}
+
+// Common French abbreviations
+var AbbreviationsFrench = []string{
+	"m", "mm", "mme", "mmes", "mlle", "mlles", "dr", "pr", "me", "st", "ste",
+	"p.ex", "c.-à-d", "etc", "cf", "env", "av", "bd", "n°", "no", "p", "pp",
+	"vol", "chap", "éd", "janv", "févr", "avr", "juill", "sept", "oct", "nov", "déc",
+}
+
+// Common Spanish abbreviations
+var AbbreviationsSpanish = []string{
+	"sr", "sra", "srta", "dr", "dra", "lic", "ing", "prof", "d", "dña", "ud", "uds",
+	"p.ej", "etc", "pág", "págs", "núm", "av", "avda", "c", "cía", "s.a", "aprox",
+	"ene", "feb", "abr", "ago", "sept", "oct", "nov", "dic",
+}
+
+// Common Italian abbreviations
+var AbbreviationsItalian = []string{
+	"sig", "sigg", "sig.ra", "dott", "dott.ssa", "prof", "ing", "avv", "arch",
+	"ecc", "es", "pag", "pagg", "n", "vol", "cap", "ca", "p.es",
+	"genn", "febbr", "apr", "ag", "sett", "ott", "nov", "dic",
+}
@@ -0,0 +1,219 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Batch adapts Embedder to BatchEmbedder. The batch is embedded either
+// sequentially or concurrently with bounded number of requests in-flight.
+type Batch struct {
+	embed       Embedder
+	concurrency int
+}
+
+var (
+	_ Embedder      = (*Batch)(nil)
+	_ BatchEmbedder = (*Batch)(nil)
+)
+
+// Creates new instance of BatchEmbedder, concurrency defines the number of
+// requests in-flight, the batch is embedded sequentially if concurrency ≤ 1.
+func NewBatch(embed Embedder, concurrency int) *Batch {
+	return &Batch{
+		embed:       embed,
+		concurrency: concurrency,
+	}
+}
+
+// Embedding calculates embedding vector of the text using the wrapped embedder.
+func (b *Batch) Embedding(ctx context.Context, text string) ([]float32, int, error) {
+	return b.embed.Embedding(ctx, text)
+}
+
+// Embeddings calculates embedding vectors for the batch, the order of vectors
+// matches the order of text. Tokens used by each call are aggregated.
+// It fails with the first error.
+func (b *Batch) Embeddings(ctx context.Context, text []string) ([][]float32, int, error) {
+	vectors, used, err := b.embeddings(ctx, text)
+	return vectors, sum(used), err
+}
+
+// embeddings of the batch, tokens used by each text are reported individually
+func (b *Batch) embeddings(ctx context.Context, text []string) ([][]float32, []int, error) {
+	if b.concurrency <= 1 || len(text) <= 1 {
+		return b.sequential(ctx, text)
+	}
+
+	return b.concurrent(ctx, text)
+}
+
+func (b *Batch) sequential(ctx context.Context, text []string) ([][]float32, []int, error) {
+	vectors := make([][]float32, len(text))
+	used := make([]int, len(text))
+
+	for i, txt := range text {
+		if err := ctx.Err(); err != nil {
+			return nil, used, err
+		}
+
+		v32, n, err := b.embed.Embedding(ctx, txt)
+		used[i] = n
+		if err != nil {
+			return nil, used, fmt.Errorf("%w, for {%s}", err, txt)
+		}
+		vectors[i] = v32
+	}
+
+	return vectors, used, nil
+}
+
+func (b *Batch) concurrent(ctx context.Context, text []string) ([][]float32, []int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	vectors := make([][]float32, len(text))
+	used := make([]int, len(text))
+
+	var (
+		wg   sync.WaitGroup
+		once sync.Once
+		fail error
+	)
+
+	queue := make(chan int)
+	for range min(b.concurrency, len(text)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				v32, n, err := b.embed.Embedding(ctx, text[i])
+				used[i] = n
+				if err != nil {
+					once.Do(func() {
+						fail = fmt.Errorf("%w, for {%s}", err, text[i])
+						cancel()
+					})
+					continue
+				}
+				vectors[i] = v32
+			}
+		}()
+	}
+
+feed:
+	for i := range text {
+		select {
+		case queue <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if fail == nil {
+		fail = ctx.Err()
+	}
+
+	if fail != nil {
+		return nil, used, fail
+	}
+
+	return vectors, used, nil
+}
+
+func sum(seq []int) int {
+	n := 0
+	for _, x := range seq {
+		n += x
+	}
+	return n
+}
+
+// embeddings of text, using batch interface if the embedder supports it,
+// otherwise concurrently with bounded number of requests in-flight.
+// It returns tokens used by each text and the number of embedding calls.
+// Tokens used by batch interface are evenly distributed across texts.
+func embeddings(ctx context.Context, embed Embedder, concurrency int, text []string) ([][]float32, []int, int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, 0, err
+	}
+
+	var (
+		vectors [][]float32
+		used    []int
+		calls   int
+		err     error
+	)
+
+	if batch, ok := embed.(BatchEmbedder); ok && batches(embed) {
+		var tokens int
+		calls = 1
+		vectors, tokens, err = batch.Embeddings(ctx, text)
+		used = make([]int, len(text))
+		for i := range used {
+			used[i] = tokens / len(text)
+		}
+		if len(used) > 0 {
+			used[0] += tokens % len(text)
+		}
+	} else {
+		calls = len(text)
+		vectors, used, err = NewBatch(embed, concurrency).embeddings(ctx, text)
+	}
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, used, calls, ctx.Err()
+		}
+		return nil, used, calls, fmt.Errorf("embedding has failed: %w", err)
+	}
+
+	if len(vectors) != len(text) {
+		return nil, used, calls, fmt.Errorf("embedding has failed: %d vectors for %d texts", len(vectors), len(text))
+	}
+
+	return vectors, used, calls, nil
+}
+
+// batches of wrapped embedder (e.g. [Cache] or [Retry]), the embedder is
+// called for each text sequentially if it does not implement BatchEmbedder.
+func batchOf(ctx context.Context, embed Embedder, text []string) ([][]float32, int, error) {
+	if batch, ok := embed.(BatchEmbedder); ok && batches(embed) {
+		return batch.Embeddings(ctx, text)
+	}
+
+	return NewBatch(embed, 1).Embeddings(ctx, text)
+}
+
+// middleware implements BatchEmbedder, it batches if the wrapped embedder does
+type batcher interface {
+	batches() bool
+}
+
+// embedder is capable to embed the batch with a single call
+func batches(embed Embedder) bool {
+	if _, ok := embed.(BatchEmbedder); !ok {
+		return false
+	}
+
+	if b, ok := embed.(batcher); ok {
+		return b.batches()
+	}
+
+	return true
+}
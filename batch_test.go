@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestBatch(t *testing.T) {
+	text := []string{"a.", "bb.", "c.", "ddd.", "ff."}
+
+	for _, n := range []int{0, 1, 2, 8} {
+		vectors, tokens, err := scanner.NewBatch(topic{}, n).Embeddings(context.Background(), text)
+
+		it.Then(t).Should(
+			it.Nil(err),
+			it.Equal(len(vectors), len(text)),
+			it.Equal(tokens, 14),
+			it.Seq(vectors[1]).Equal(0, 1, 0, 0),
+			it.Seq(vectors[3]).Equal(0, 0, 0, 1),
+		)
+	}
+}
+
+func TestBatchFailure(t *testing.T) {
+	text := []string{"a.", "bb.", "fail", "ddd.", "ff."}
+
+	for _, n := range []int{1, 4} {
+		_, _, err := scanner.NewBatch(failing{}, n).Embeddings(context.Background(), text)
+
+		it.Then(t).Should(
+			it.Fail(func() error { return err }).Contain("fail"),
+		)
+	}
+}
+
+func TestScannerBatch(t *testing.T) {
+	text := "a. bb. c. ddd. ff."
+
+	api := &batch{}
+	s := scanner.NewSemantic(
+		api,
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Similarity(similar)
+	s.Window(5)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a.", "c."),
+		it.Equal(api.calls, 1),
+	)
+}
+
+func TestScannerBatchAdapter(t *testing.T) {
+	text := "a. bb. c. ddd. ff."
+
+	s := scanner.NewSemantic(
+		scanner.NewBatch(embed{}, 4),
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Similarity(similar)
+	s.Window(5)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a.", "c."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("bb.", "ff."),
+	)
+}
+
+//------------------------------------------------------------------------------
+
+type failing struct{}
+
+func (failing) Embedding(ctx context.Context, text string) ([]float32, int, error) {
+	if text == "fail" {
+		return nil, 0, errors.New("fail")
+	}
+	return []float32{float32(len(text))}, 0, nil
+}
+
+type batch struct {
+	embed
+	calls int
+}
+
+func (b *batch) Embeddings(ctx context.Context, text []string) ([][]float32, int, error) {
+	b.calls++
+	return scanner.NewBatch(b.embed, 1).Embeddings(ctx, text)
+}
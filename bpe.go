@@ -0,0 +1,232 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// BPE is byte-level Byte-Pair Encoding tokenizer compatible with GPT-2 family
+// of models. The tokenizer is defined by vocabulary (JSON object mapping
+// tokens to ids) and ranked merges (text file, one pair per line).
+type BPE struct {
+	vocab map[string]int
+	ranks map[[2]string]int
+	bytes [256]string
+}
+
+var _ Tokenizer = (*BPE)(nil)
+
+// Creates BPE tokenizer from vocabulary and merges files.
+func LoadBPE(vocabFile, mergesFile string) (*BPE, error) {
+	vocab, err := os.Open(vocabFile)
+	if err != nil {
+		return nil, err
+	}
+	defer vocab.Close()
+
+	merges, err := os.Open(mergesFile)
+	if err != nil {
+		return nil, err
+	}
+	defer merges.Close()
+
+	return NewBPE(vocab, merges)
+}
+
+// Creates BPE tokenizer from vocabulary and merges streams.
+func NewBPE(vocab, merges io.Reader) (*BPE, error) {
+	bpe := &BPE{
+		vocab: make(map[string]int),
+		ranks: make(map[[2]string]int),
+		bytes: byteToRune(),
+	}
+
+	if err := json.NewDecoder(vocab).Decode(&bpe.vocab); err != nil {
+		return nil, fmt.Errorf("invalid vocabulary: %w", err)
+	}
+
+	s := bufio.NewScanner(merges)
+	for rank := 0; s.Scan(); {
+		line := strings.TrimSpace(s.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#version") {
+			continue
+		}
+
+		pair := strings.Fields(line)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("invalid merge {%s}", line)
+		}
+
+		bpe.ranks[[2]string{pair[0], pair[1]}] = rank
+		rank++
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return bpe, nil
+}
+
+// Count number of tokens in the text
+func (bpe *BPE) Count(text string) int {
+	n := 0
+	for _, word := range pretokenize(text) {
+		n += len(bpe.merge(word))
+	}
+	return n
+}
+
+// Encode text to sequence of token ids. Tokens not defined by
+// the vocabulary are encoded as -1.
+func (bpe *BPE) Encode(text string) []int {
+	ids := make([]int, 0)
+	for _, word := range pretokenize(text) {
+		for _, token := range bpe.merge(word) {
+			id, has := bpe.vocab[token]
+			if !has {
+				id = -1
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// merge symbols of the word using ranked pairs
+func (bpe *BPE) merge(word string) []string {
+	symbols := make([]string, 0, len(word))
+	for i := 0; i < len(word); i++ {
+		symbols = append(symbols, bpe.bytes[word[i]])
+	}
+
+	for len(symbols) > 1 {
+		best, at := -1, -1
+		for i := 0; i < len(symbols)-1; i++ {
+			if rank, has := bpe.ranks[[2]string{symbols[i], symbols[i+1]}]; has && (best == -1 || rank < best) {
+				best, at = rank, i
+			}
+		}
+
+		if at == -1 {
+			break
+		}
+
+		a, b := symbols[at], symbols[at+1]
+		seq := symbols[:0:0]
+		for i := 0; i < len(symbols); i++ {
+			if i < len(symbols)-1 && symbols[i] == a && symbols[i+1] == b {
+				seq = append(seq, a+b)
+				i++
+			} else {
+				seq = append(seq, symbols[i])
+			}
+		}
+		symbols = seq
+	}
+
+	return symbols
+}
+
+// split text into words following GPT-2 pattern
+//
+//	's|'t|'re|'ve|'m|'ll|'d| ?\p{L}+| ?\p{N}+| ?[^\s\p{L}\p{N}]+|\s+(?!\S)|\s+
+func pretokenize(text string) []string {
+	words := make([]string, 0)
+
+	for i := 0; i < len(text); {
+		if n := contraction(text[i:]); n > 0 {
+			words = append(words, text[i:i+n])
+			i += n
+			continue
+		}
+
+		j := i
+		if text[j] == ' ' && j+1 < len(text) {
+			if r, _ := utf8.DecodeRuneInString(text[j+1:]); !unicode.IsSpace(r) {
+				j++
+			}
+		}
+
+		r, _ := utf8.DecodeRuneInString(text[j:])
+		switch {
+		case unicode.IsLetter(r):
+			j = span(text, j, unicode.IsLetter)
+		case unicode.IsNumber(r):
+			j = span(text, j, unicode.IsNumber)
+		case !unicode.IsSpace(r):
+			j = span(text, j, func(r rune) bool {
+				return !unicode.IsSpace(r) && !unicode.IsLetter(r) && !unicode.IsNumber(r)
+			})
+		default:
+			// whitespaces, the last one is left for the following word
+			j = span(text, j, unicode.IsSpace)
+			if j < len(text) {
+				_, n := utf8.DecodeLastRuneInString(text[i:j])
+				if j-n > i {
+					j -= n
+				}
+			}
+		}
+
+		words = append(words, text[i:j])
+		i = j
+	}
+
+	return words
+}
+
+func contraction(text string) int {
+	if len(text) < 2 || text[0] != '\'' {
+		return 0
+	}
+
+	for _, x := range []string{"'re", "'ve", "'ll", "'s", "'t", "'m", "'d"} {
+		if strings.HasPrefix(text, x) {
+			return len(x)
+		}
+	}
+	return 0
+}
+
+func span(text string, at int, f func(rune) bool) int {
+	for at < len(text) {
+		r, n := utf8.DecodeRuneInString(text[at:])
+		if !f(r) {
+			break
+		}
+		at += n
+	}
+	return at
+}
+
+// GPT-2 mapping of bytes to printable runes
+func byteToRune() [256]string {
+	var seq [256]string
+
+	n := 0
+	for b := 0; b < 256; b++ {
+		if ('!' <= b && b <= '~') || (0xA1 <= b && b <= 0xAC) || (0xAE <= b && b <= 0xFF) {
+			seq[b] = string(rune(b))
+		} else {
+			seq[b] = string(rune(256 + n))
+			n++
+		}
+	}
+
+	return seq
+}
@@ -0,0 +1,53 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+const (
+	bpeVocab  = `{"h": 0, "e": 1, "l": 2, "o": 3, "he": 4, "ll": 5, "hell": 6, "hello": 7, "Ġ": 8, "!": 9, "Ġhello": 10}`
+	bpeMerges = "#version: 0.2\nh e\nl l\nhe ll\nhell o\nĠ hello\n"
+)
+
+func TestBPE(t *testing.T) {
+	bpe, err := scanner.NewBPE(strings.NewReader(bpeVocab), strings.NewReader(bpeMerges))
+
+	it.Then(t).Should(
+		it.Nil(err),
+		it.Seq(bpe.Encode("hello")).Equal(7),
+		it.Seq(bpe.Encode("hello hello!")).Equal(7, 10, 9),
+		it.Seq(bpe.Encode("hel")).Equal(4, 2),
+		it.Seq(bpe.Encode("hex")).Equal(4, -1),
+		it.Equal(bpe.Count("hello hello!"), 3),
+		it.Equal(bpe.Count("  hello"), 2),
+	)
+}
+
+func TestLoadBPE(t *testing.T) {
+	dir := t.TempDir()
+	vocab := filepath.Join(dir, "vocab.json")
+	merges := filepath.Join(dir, "merges.txt")
+	os.WriteFile(vocab, []byte(bpeVocab), 0644)
+	os.WriteFile(merges, []byte(bpeMerges), 0644)
+
+	bpe, err := scanner.LoadBPE(vocab, merges)
+
+	it.Then(t).Should(
+		it.Nil(err),
+		it.Equal(bpe.Count("hello"), 1),
+	)
+}
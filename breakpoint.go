@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"slices"
+
+	"github.com/chewxy/math32"
+)
+
+// Percentile breakpoint splits at distances above the p-th percentile
+// (0 ≤ p ≤ 100) of adjacent distances within the window.
+// Use 95 as a reasonable starting point.
+func PercentileBreakpoint(p float32) func([]float32) []bool {
+	return func(d []float32) []bool {
+		return above(d, percentile(d, p))
+	}
+}
+
+// Standard deviation breakpoint splits at distances above mean + k·stddev of
+// adjacent distances within the window. Use 3 as a reasonable starting point.
+func StdDevBreakpoint(k float32) func([]float32) []bool {
+	return func(d []float32) []bool {
+		if len(d) == 0 {
+			return nil
+		}
+
+		mean := float32(0.0)
+		for _, x := range d {
+			mean += x
+		}
+		mean /= float32(len(d))
+
+		vari := float32(0.0)
+		for _, x := range d {
+			vari += (x - mean) * (x - mean)
+		}
+		vari /= float32(len(d))
+
+		return above(d, mean+k*math32.Sqrt(vari))
+	}
+}
+
+// Interquartile breakpoint splits at distances above Q3 + k·IQR of
+// adjacent distances within the window. Use 1.5 as a reasonable starting point.
+func InterquartileBreakpoint(k float32) func([]float32) []bool {
+	return func(d []float32) []bool {
+		q1, q3 := percentile(d, 25), percentile(d, 75)
+		return above(d, q3+k*(q3-q1))
+	}
+}
+
+// Gradient breakpoint splits at large jumps of distances, where gradient of
+// adjacent distances is above its p-th percentile (0 ≤ p ≤ 100). It is
+// suitable for highly correlated texts, where distances are uniformly low.
+// Use 95 as a reasonable starting point.
+func GradientBreakpoint(p float32) func([]float32) []bool {
+	return func(d []float32) []bool {
+		g := gradient(d)
+		return above(g, percentile(g, p))
+	}
+}
+
+// marks elements above the threshold
+func above(d []float32, threshold float32) []bool {
+	seq := make([]bool, len(d))
+	for i, x := range d {
+		seq[i] = x > threshold
+	}
+	return seq
+}
+
+// percentile of sequence using linear interpolation between closest ranks
+func percentile(d []float32, p float32) float32 {
+	if len(d) == 0 {
+		return 0.0
+	}
+
+	seq := slices.Clone(d)
+	slices.Sort(seq)
+
+	rank := p / 100 * float32(len(seq)-1)
+	lo := int(math32.Floor(rank))
+	hi := int(math32.Ceil(rank))
+	lo = max(0, min(lo, len(seq)-1))
+	hi = max(0, min(hi, len(seq)-1))
+
+	return seq[lo] + (rank-float32(lo))*(seq[hi]-seq[lo])
+}
+
+// gradient of sequence, using forward differences, so that the jump is
+// marked at the distance it happens. The first distance has no gradient.
+func gradient(d []float32) []float32 {
+	g := make([]float32, len(d))
+	for i := 1; i < len(d); i++ {
+		g[i] = d[i] - d[i-1]
+	}
+	return g
+}
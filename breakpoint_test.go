@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestBreakpoint(t *testing.T) {
+	d := []float32{0.1, 0.1, 0.9, 0.1, 0.1, 0.1, 0.1, 0.1}
+
+	for name, f := range map[string]func([]float32) []bool{
+		"percentile":    scanner.PercentileBreakpoint(95),
+		"stddev":        scanner.StdDevBreakpoint(2),
+		"interquartile": scanner.InterquartileBreakpoint(1.5),
+		"gradient":      scanner.GradientBreakpoint(95),
+	} {
+		t.Run(name, func(t *testing.T) {
+			seq := f(d)
+			it.Then(t).Should(
+				it.Seq(seq).Equal(false, false, true, false, false, false, false, false),
+			)
+		})
+	}
+}
+
+func TestBreakpointEmpty(t *testing.T) {
+	for _, f := range []func([]float32) []bool{
+		scanner.PercentileBreakpoint(95),
+		scanner.StdDevBreakpoint(2),
+		scanner.InterquartileBreakpoint(1.5),
+		scanner.GradientBreakpoint(95),
+	} {
+		it.Then(t).Should(
+			it.Equal(len(f(nil)), 0),
+			it.Seq(f([]float32{0.5})).Equal(false),
+		)
+	}
+}
+
+func TestScannerBreakpoint(t *testing.T) {
+	text := "a1. a2. a3. b1. b2. b3. c1. c2."
+
+	s := scanner.NewSemantic(
+		topic{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Breakpoint(scanner.PercentileBreakpoint(50))
+	s.Window(8)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a1.", "a2.", "a3."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("b1.", "b2.", "b3."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("c1.", "c2."),
+	)
+
+	it.Then(t).ShouldNot(
+		it.True(s.Scan()),
+	)
+}
+
+func TestScannerBreakpointGrouping(t *testing.T) {
+	text := "a1. b1. a2. b2."
+
+	s := scanner.NewSemantic(
+		topic{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Breakpoint(scanner.PercentileBreakpoint(50))
+	s.Grouping(scanner.GROUPING_WINDOW)
+	s.Window(4)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a1.", "a2."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("b1.", "b2."),
+	)
+
+	it.Then(t).ShouldNot(
+		it.True(s.Scan()),
+	)
+}
+
+func TestScannerBreakpointGradient(t *testing.T) {
+	text := "a1. a2. a3. a4. b1. b2. b3. b4."
+
+	s := scanner.NewSemantic(
+		topic{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Breakpoint(scanner.GradientBreakpoint(95))
+	s.Window(8)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a1.", "a2.", "a3.", "a4."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("b1.", "b2.", "b3.", "b4."),
+	)
+}
+
+//------------------------------------------------------------------------------
+
+// topic embeds sentences by the first letter, sentences on the same topic
+// are identical vectors, distinct topics are orthogonal.
+type topic struct{}
+
+func (topic) Embedding(ctx context.Context, text string) ([]float32, int, error) {
+	v := make([]float32, 4)
+	v[int(text[0]-'a')%4] = 1.0
+	return v, len(text), nil
+}
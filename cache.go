@@ -0,0 +1,285 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+)
+
+// Storage of embedding vectors used by the cache.
+// Get returns false if the key is not found.
+type Storage interface {
+	Get(key string) ([]float32, bool, error)
+	Put(key string, vector []float32) error
+}
+
+// Cache is Embedder that caches embedding vectors of underlying embedder.
+// Vectors are keyed by content hash of the text and the model id, so that
+// vectors from different models are not mixed. Cached vectors report zero
+// tokens used.
+type Cache struct {
+	embed  Embedder
+	store  Storage
+	model  string
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+var (
+	_ Embedder      = (*Cache)(nil)
+	_ BatchEmbedder = (*Cache)(nil)
+)
+
+// Creates new instance of caching Embedder, model identifies the embedding
+// model, use distinct identities for distinct models sharing the storage.
+func NewCache(embed Embedder, model string, store Storage) *Cache {
+	return &Cache{
+		embed: embed,
+		store: store,
+		model: model,
+	}
+}
+
+// Hits returns number of embeddings served from the cache.
+func (c *Cache) Hits() int64 { return c.hits.Load() }
+
+// Misses returns number of embeddings served by the underlying embedder.
+func (c *Cache) Misses() int64 { return c.misses.Load() }
+
+func (c *Cache) Embedding(ctx context.Context, text string) ([]float32, int, error) {
+	key := c.key(text)
+
+	v32, has, err := c.store.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if has {
+		c.hits.Add(1)
+		return v32, 0, nil
+	}
+
+	c.misses.Add(1)
+	v32, n, err := c.embed.Embedding(ctx, text)
+	if err != nil {
+		return nil, n, err
+	}
+
+	if err := c.store.Put(key, v32); err != nil {
+		return nil, n, err
+	}
+
+	return v32, n, nil
+}
+
+// Embeddings looks up vectors of the batch in the cache, missing vectors
+// are calculated by the underlying embedder with a single batch call.
+func (c *Cache) Embeddings(ctx context.Context, text []string) ([][]float32, int, error) {
+	vectors := make([][]float32, len(text))
+	misses := make(map[string][]int)
+	keys := make([]string, 0)
+	seq := make([]string, 0)
+
+	for i, txt := range text {
+		key := c.key(txt)
+
+		v32, has, err := c.store.Get(key)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if has {
+			c.hits.Add(1)
+			vectors[i] = v32
+			continue
+		}
+
+		c.misses.Add(1)
+		if _, has := misses[key]; !has {
+			keys = append(keys, key)
+			seq = append(seq, txt)
+		}
+		misses[key] = append(misses[key], i)
+	}
+
+	if len(seq) == 0 {
+		return vectors, 0, nil
+	}
+
+	v32s, n, err := batchOf(ctx, c.embed, seq)
+	if err != nil {
+		return nil, n, err
+	}
+
+	if len(v32s) != len(seq) {
+		return nil, n, fmt.Errorf("embedding has failed: %d vectors for %d texts", len(v32s), len(seq))
+	}
+
+	for k, key := range keys {
+		if err := c.store.Put(key, v32s[k]); err != nil {
+			return nil, n, err
+		}
+		for _, i := range misses[key] {
+			vectors[i] = v32s[k]
+		}
+	}
+
+	return vectors, n, nil
+}
+
+func (c *Cache) batches() bool { return batches(c.embed) }
+
+// content hash of text
+func (c *Cache) key(text string) string {
+	h := sha256.New()
+	h.Write([]byte(c.model))
+	h.Write([]byte{0})
+	h.Write([]byte(text))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+//------------------------------------------------------------------------------
+
+// LRU is in-memory Storage, which evicts least recently used vectors.
+type LRU struct {
+	mu   sync.Mutex
+	size int
+	seq  *list.List
+	keys map[string]*list.Element
+}
+
+type lruEntry struct {
+	key    string
+	vector []float32
+}
+
+var _ Storage = (*LRU)(nil)
+
+// Creates new in-memory Storage capable to hold n vectors.
+func NewLRU(n int) *LRU {
+	return &LRU{
+		size: n,
+		seq:  list.New(),
+		keys: make(map[string]*list.Element),
+	}
+}
+
+func (lru *LRU) Get(key string) ([]float32, bool, error) {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+
+	e, has := lru.keys[key]
+	if !has {
+		return nil, false, nil
+	}
+
+	lru.seq.MoveToFront(e)
+	return e.Value.(lruEntry).vector, true, nil
+}
+
+func (lru *LRU) Put(key string, vector []float32) error {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+
+	if e, has := lru.keys[key]; has {
+		e.Value = lruEntry{key: key, vector: vector}
+		lru.seq.MoveToFront(e)
+		return nil
+	}
+
+	lru.keys[key] = lru.seq.PushFront(lruEntry{key: key, vector: vector})
+
+	for lru.seq.Len() > lru.size {
+		e := lru.seq.Back()
+		lru.seq.Remove(e)
+		delete(lru.keys, e.Value.(lruEntry).key)
+	}
+
+	return nil
+}
+
+//------------------------------------------------------------------------------
+
+// Dir is on-disk Storage, which keeps each vector as binary file within
+// the directory. Vectors are encoded as little-endian float32.
+type Dir string
+
+var _ Storage = Dir("")
+
+func (dir Dir) Get(key string) ([]float32, bool, error) {
+	b, err := os.ReadFile(dir.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if len(b)%4 != 0 {
+		return nil, false, errors.New("corrupted vector " + key)
+	}
+
+	v32 := make([]float32, len(b)/4)
+	for i := range v32 {
+		v32[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[i*4:]))
+	}
+
+	return v32, true, nil
+}
+
+func (dir Dir) Put(key string, vector []float32) error {
+	b := make([]byte, len(vector)*4)
+	for i, x := range vector {
+		binary.LittleEndian.PutUint32(b[i*4:], math.Float32bits(x))
+	}
+
+	path := dir.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// write then rename, concurrent readers never observe partial vectors
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// vectors are sharded by the key prefix
+func (dir Dir) path(key string) string {
+	if len(key) > 2 {
+		return filepath.Join(string(dir), key[:2], key)
+	}
+	return filepath.Join(string(dir), key)
+}
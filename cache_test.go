@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestCache(t *testing.T) {
+	for name, store := range map[string]scanner.Storage{
+		"lru": scanner.NewLRU(10),
+		"dir": scanner.Dir(t.TempDir()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			api := scanner.NewCache(topic{}, "topic", store)
+
+			a, an, aerr := api.Embedding(context.Background(), "bb.")
+			b, bn, berr := api.Embedding(context.Background(), "bb.")
+
+			it.Then(t).Should(
+				it.Nil(aerr),
+				it.Nil(berr),
+				it.Seq(a).Equal(0, 1, 0, 0),
+				it.Seq(b).Equal(0, 1, 0, 0),
+				it.Equal(an, 3),
+				it.Equal(bn, 0),
+				it.Equal(api.Hits(), 1),
+				it.Equal(api.Misses(), 1),
+			)
+		})
+	}
+}
+
+func TestCacheModel(t *testing.T) {
+	store := scanner.NewLRU(10)
+	a := scanner.NewCache(topic{}, "a", store)
+	b := scanner.NewCache(topic{}, "b", store)
+
+	a.Embedding(context.Background(), "bb.")
+	b.Embedding(context.Background(), "bb.")
+
+	it.Then(t).Should(
+		it.Equal(a.Misses(), 1),
+		it.Equal(b.Misses(), 1),
+	)
+}
+
+func TestCacheEmbeddings(t *testing.T) {
+	b := &batch{}
+	api := scanner.NewCache(b, "embed", scanner.NewLRU(10))
+
+	api.Embedding(context.Background(), "a.")
+	seq, _, err := api.Embeddings(context.Background(), []string{"a.", "bb.", "ccc.", "bb."})
+	again, _, _ := api.Embeddings(context.Background(), []string{"ccc.", "a."})
+
+	it.Then(t).Should(
+		it.Nil(err),
+		it.Equal(len(seq), 4),
+		it.Seq(seq[0]).Equal(2),
+		it.Seq(seq[1]).Equal(3),
+		it.Seq(seq[2]).Equal(4),
+		it.Seq(seq[3]).Equal(3),
+		it.Seq(again[0]).Equal(4),
+		it.Seq(again[1]).Equal(2),
+		it.Equal(b.calls, 1),
+		it.Equal(api.Hits(), 3),
+		it.Equal(api.Misses(), 4),
+	)
+}
+
+func TestScannerCacheBatch(t *testing.T) {
+	text := "a. bb. c. ddd. ff."
+
+	api := &batch{}
+	s := scanner.NewSemantic(
+		scanner.NewCache(api, "embed", scanner.NewLRU(10)),
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Similarity(similar)
+	s.Window(5)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a.", "c."),
+		it.Equal(api.calls, 1),
+	)
+}
+
+func TestLRU(t *testing.T) {
+	lru := scanner.NewLRU(2)
+	lru.Put("a", []float32{1})
+	lru.Put("b", []float32{2})
+	lru.Get("a")
+	lru.Put("c", []float32{3})
+
+	_, hasA, _ := lru.Get("a")
+	_, hasB, _ := lru.Get("b")
+	_, hasC, _ := lru.Get("c")
+
+	it.Then(t).Should(
+		it.True(hasA),
+		it.True(!hasB),
+		it.True(hasC),
+	)
+}
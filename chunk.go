@@ -0,0 +1,164 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Position within the source document.
+type Position struct {
+	Offset    int // byte offset, starting at 0
+	Rune      int // rune offset, starting at 0
+	Line      int // line number, starting at 1
+	Column    int // column number in runes, starting at 1
+	Paragraph int // paragraph index, starting at 0
+}
+
+// Beginning of the document
+var origin = Position{Line: 1, Column: 1}
+
+// forward position over the bytes
+func forward(p Position, b []byte) Position {
+	for len(b) > 0 {
+		r, n := utf8.DecodeRune(b)
+		b = b[n:]
+
+		p.Offset += n
+		p.Rune++
+		if r == '\n' {
+			p.Line++
+			p.Column = 1
+		} else {
+			p.Column++
+		}
+	}
+	return p
+}
+
+// tracks paragraphs of the document, paragraphs are separated by one or
+// more blank lines.
+type paragraphs struct {
+	index int
+	blank bool // the current line is blank so far
+	text  bool // the current paragraph has text
+}
+
+// forward paragraphs over the bytes, returns index of the current paragraph
+func (p *paragraphs) forward(b []byte) int {
+	for len(b) > 0 {
+		r, n := utf8.DecodeRune(b)
+		b = b[n:]
+
+		switch {
+		case r == '\n':
+			if p.blank && p.text {
+				p.index++
+				p.text = false
+			}
+			p.blank = true
+		case !unicode.IsSpace(r):
+			p.blank = false
+			p.text = true
+		}
+	}
+	return p.index
+}
+
+// Span of the source document [Start, End).
+type Span struct {
+	Start Position
+	End   Position
+}
+
+// Chunk is a piece of text, annotated with its position at source document.
+// Start and End defines the span of chunk. Chunks made of non-adjacent
+// sentences define each contributing span. Sentences lists indexes of
+// sentences (units produced by the upstream scanner) the chunk is made of.
+// Overlap is the length in bytes of Text prefix that repeats the tail of
+// the preceding chunk.
+//
+// The position is tracked by [Splitter] (e.g. [NewSentences]). It is unknown
+// for other upstream scanners (e.g. [bufio.Scanner]). The unknown position
+// is zero and the chunk has no spans.
+type Chunk struct {
+	Span
+	Text      string
+	Spans     []Span
+	Sentences []int
+	Overlap   int
+}
+
+// Scanner that annotates text with position at source document.
+type chunked interface {
+	Chunk() Chunk
+}
+
+// position is known, the line number starts at 1
+func known(p Position) bool {
+	return p.Line > 0
+}
+
+// tracks units produced by the upstream scanner. The position is unknown
+// (zero span) if the upstream scanner is not capable to annotate the text,
+// the text of unit is not sufficient to estimate it (e.g. [bufio.ScanLines]
+// drops line breaks).
+type tracker struct {
+	seq int
+}
+
+func newTracker() tracker {
+	return tracker{}
+}
+
+// chunk for the current unit of the scanner
+func (t *tracker) chunkOf(s Scanner) Chunk {
+	if c, ok := s.(chunked); ok {
+		return c.Chunk()
+	}
+
+	c := Chunk{Text: s.Text(), Sentences: []int{t.seq}}
+	t.seq++
+	return c
+}
+
+// join sequence of chunks, adjacent sentences are merged into single span
+func joinChunks(text string, seq []Chunk) Chunk {
+	if len(seq) == 0 {
+		return Chunk{Text: text}
+	}
+
+	c := Chunk{
+		Span:      seq[0].Span,
+		Text:      text,
+		Spans:     make([]Span, 0, len(seq)),
+		Sentences: make([]int, 0, len(seq)),
+	}
+
+	for _, x := range seq {
+		spans := x.Spans
+		if len(c.Sentences) > 0 && len(spans) > 0 && len(x.Sentences) > 0 &&
+			c.Sentences[len(c.Sentences)-1]+1 == x.Sentences[0] {
+			c.Spans[len(c.Spans)-1].End = spans[0].End
+			spans = spans[1:]
+		}
+		c.Spans = append(c.Spans, spans...)
+		c.Sentences = append(c.Sentences, x.Sentences...)
+
+		if x.Start.Offset < c.Start.Offset {
+			c.Start = x.Start
+		}
+		if x.End.Offset > c.End.Offset {
+			c.End = x.End
+		}
+	}
+
+	return c
+}
@@ -9,33 +9,342 @@
 package scanner
 
 import (
+	"sort"
 	"strings"
 )
 
+// Chunker groups units of upstream scanner into chunks of the given size.
+// The size is measured in bytes, the chunk is emitted once it exceeds
+// the size. Use Tokenizer method to measure the size in tokens and SizeLimit
+// method to never exceed the size.
 type Chunker struct {
 	Scanner
-	size int
-	sbuf strings.Builder
+	size          int
+	confSizeLimit SizeLimit
+	confMinSize   int
+	tokenizer     Tokenizer
+	sbuf          strings.Builder
+	track         tracker
+	confOverlap   int
+	confOverlapIn OverlapIn
+	confJoiner    string
+	confSource    bool
+	carry         []unit
+	tail          []unit
+	ahead         *group
+	group         group
 }
 
+// Configure measure of overlap between chunks
+type OverlapIn int
+
+// Configure measure of overlap between chunks
+const (
+	OVERLAP_IN_UNITS OverlapIn = iota
+	OVERLAP_IN_SIZE
+)
+
+// Configure size limit of chunks
+type SizeLimit int
+
+// Configure size limit of chunks
+const (
+	SIZE_LIMIT_SOFT SizeLimit = iota
+	SIZE_LIMIT_HARD
+)
+
 func NewChunker(size int, s Scanner) *Chunker {
 	return &Chunker{
-		Scanner: s,
-		size:    size,
+		Scanner:       s,
+		size:          size,
+		confSizeLimit: SIZE_LIMIT_SOFT,
+		track:         newTracker(),
 	}
 }
 
+// Tokenizer sets the size of chunk in tokens. The chunk accumulates units
+// of upstream scanner until the limit is reached, the unit that would
+// overflow the limit is carried over to the next chunk. The chunk exceeds
+// the limit only if a single unit is already too big.
+func (s *Chunker) Tokenizer(t Tokenizer) {
+	s.tokenizer = t
+}
+
+// SizeLimit sets the behavior of chunking algorithm.
+//
+// Using SIZE_LIMIT_SOFT configures algorithm to emit the chunk once it exceeds
+// the size in bytes, the chunk overshoots the size by up to one upstream unit.
+// The size in tokens is exceeded only if a single unit is already too big.
+//
+// Using SIZE_LIMIT_HARD configures algorithm to never exceed the size.
+// The unit that would overflow the chunk is carried over to the next one.
+// Units that are larger than the size are split at rune boundaries.
+func (s *Chunker) SizeLimit(x SizeLimit) {
+	s.confSizeLimit = x
+}
+
+// MinSize sets the minimal size of the chunk. Tiny trailing chunk is merged
+// into the preceding one unless the merged chunk exceeds the hard size limit.
+// The default value is 0, chunks are not merged.
+func (s *Chunker) MinSize(n int) {
+	s.confMinSize = n
+}
+
+// Overlap sets the overlap between neighbouring chunks, the tail of
+// the chunk is repeated at the head of the following one. The overlap is
+// measured either in units of upstream scanner (OVERLAP_IN_UNITS) or in
+// the same measure as the size of chunk (OVERLAP_IN_SIZE), bytes or tokens.
+// The overlap is made of whole units and never covers the entire chunk.
+// The length of overlap region is available through [Chunk] metadata.
+func (s *Chunker) Overlap(n int, in OverlapIn) {
+	s.confOverlap = n
+	s.confOverlapIn = in
+}
+
+// Joiner sets the separator inserted between units of upstream scanner.
+// The default value is empty string, units are concatenated.
+func (s *Chunker) Joiner(sep string) {
+	s.confJoiner = sep
+	s.confSource = false
+}
+
+// JoinSource configures the chunker to reconstruct the original separators
+// between units (e.g. whitespaces or delimiters), so that the chunk is
+// an exact substring of the input. It requires the upstream scanner
+// to be [Splitter] (e.g. [NewSentences] or [NewSlices]), units are
+// concatenated otherwise.
+func (s *Chunker) JoinSource() {
+	s.confJoiner = ""
+	s.confSource = true
+}
+
 func (s *Chunker) Scan() bool {
+	var g group
+	if s.confMinSize <= 0 {
+		g = s.next()
+	} else {
+		if s.ahead == nil {
+			ahead := s.next()
+			s.ahead = &ahead
+		}
+
+		g = *s.ahead
+		ahead := s.next()
+		s.ahead = &ahead
+
+		tail := ahead.units[ahead.overlap:]
+		if len(g.units) > 0 && len(tail) > 0 && s.measureAll(tail) < s.confMinSize {
+			if s.confSizeLimit == SIZE_LIMIT_SOFT || s.measureAll(g.units)+s.measureAll(tail) <= s.size {
+				g.units = append(g.units, tail...)
+				ahead = s.next()
+				s.ahead = &ahead
+			}
+		}
+	}
+
+	s.group = g
 	s.sbuf.Reset()
+	for i, unit := range g.units {
+		if i > 0 {
+			s.sbuf.WriteString(s.separator(unit))
+		}
+		s.sbuf.WriteString(unit.Text)
+	}
+
+	return len(g.units) > 0
+}
+
+// unit of upstream scanner and its preceding separator at the source
+type unit struct {
+	Chunk
+	sep string
+}
+
+// group of units forming the chunk, leading units overlap the preceding chunk
+type group struct {
+	units   []unit
+	overlap int
+}
+
+// separator inserted before the unit
+func (s *Chunker) separator(u unit) string {
+	if s.confSource {
+		return u.sep
+	}
+	return s.confJoiner
+}
+
+// accumulate units of the next chunk
+func (s *Chunker) next() group {
+	carry := s.tokenizer != nil || s.confSizeLimit == SIZE_LIMIT_HARD
+	g := group{units: s.tail, overlap: len(s.tail)}
+	size := s.measureAll(g.units)
+	s.tail = nil
+
+	for {
+		u, ok := s.pull()
+		if !ok {
+			break
+		}
+
+		n := s.measure(u.Text)
+		if s.confSizeLimit == SIZE_LIMIT_HARD && n > s.size {
+			pieces := s.split(u)
+			u, n = pieces[0], s.measure(pieces[0].Text)
+			s.carry = append(pieces[1:], s.carry...)
+		}
+
+		// the overlap gives a room for the first unit
+		for carry && g.overlap > 0 && len(g.units) == g.overlap && size+n+s.measure(s.separator(u)) > s.size {
+			g.units = g.units[1:]
+			g.overlap--
+			size = s.measureAll(g.units)
+		}
+
+		if len(g.units) > 0 {
+			n += s.measure(s.separator(u))
+		}
 
-	for s.Scanner.Scan() {
-		s.sbuf.WriteString(s.Scanner.Text())
-		if s.sbuf.Len() > s.size {
-			return true
+		if carry && len(g.units) > g.overlap && size+n > s.size {
+			s.carry = append([]unit{u}, s.carry...)
+			break
+		}
+
+		g.units = append(g.units, u)
+		size += n
+
+		if (carry && size >= s.size) || (!carry && size > s.size) {
+			break
+		}
+	}
+
+	if len(g.units) == g.overlap {
+		return group{}
+	}
+
+	s.tail = s.overlapOf(g.units)
+	return g
+}
+
+// tail of units overlapping the following chunk
+func (s *Chunker) overlapOf(units []unit) []unit {
+	if s.confOverlap <= 0 {
+		return nil
+	}
+
+	k := 0
+	switch s.confOverlapIn {
+	case OVERLAP_IN_SIZE:
+		for i := len(units) - 1; i > 0; i-- {
+			if s.measureAll(units[i:]) > s.confOverlap {
+				break
+			}
+			k++
+		}
+	default:
+		k = min(s.confOverlap, len(units)-1)
+	}
+
+	tail := make([]unit, k)
+	copy(tail, units[len(units)-k:])
+	return tail
+}
+
+// pull the unit either from carry over or upstream scanner
+func (s *Chunker) pull() (unit, bool) {
+	if len(s.carry) > 0 {
+		u := s.carry[0]
+		s.carry = s.carry[1:]
+		return u, true
+	}
+
+	if !s.Scanner.Scan() {
+		return unit{}, false
+	}
+
+	u := unit{Chunk: s.track.chunkOf(s.Scanner)}
+	if sep, ok := s.Scanner.(interface{ Separator() string }); ok {
+		u.sep = sep.Separator()
+	}
+
+	return u, true
+}
+
+// split the unit into pieces fitting the size, pieces are contiguous
+func (s *Chunker) split(u unit) []unit {
+	pieces := make([]unit, 0)
+	text, pos, sep := u.Text, u.Start, u.sep
+
+	for len(text) > 0 {
+		n := s.cut(text)
+		c := Chunk{Text: text[:n], Sentences: u.Sentences}
+		if known(pos) {
+			end := forward(pos, []byte(text[:n]))
+			c.Span = Span{Start: pos, End: end}
+			c.Spans = []Span{c.Span}
+			pos = end
+		}
+		pieces = append(pieces, unit{Chunk: c, sep: sep})
+		text, sep = text[n:], ""
+	}
+
+	return pieces
+}
+
+// length of the longest prefix fitting the size, at least one rune
+func (s *Chunker) cut(text string) int {
+	bounds := make([]int, 0, len(text))
+	for i := range text {
+		if i > 0 {
+			bounds = append(bounds, i)
 		}
 	}
+	bounds = append(bounds, len(text))
+
+	at := sort.Search(len(bounds), func(i int) bool {
+		return s.measure(text[:bounds[i]]) > s.size
+	})
 
-	return s.sbuf.Len() > 0
+	return bounds[max(at-1, 0)]
+}
+
+func (s *Chunker) measure(text string) int {
+	if s.tokenizer != nil {
+		return s.tokenizer.Count(text)
+	}
+	return len(text)
+}
+
+func (s *Chunker) measureAll(units []unit) int {
+	n := 0
+	for i, u := range units {
+		if i > 0 {
+			n += s.measure(s.separator(u))
+		}
+		n += s.measure(u.Text)
+	}
+	return n
 }
 
 func (s *Chunker) Text() string { return s.sbuf.String() }
+
+// stops the upstream created by the library (see [FromSeq])
+func (s *Chunker) release() { release(s.Scanner) }
+
+// Chunk returns the most recent chunk annotated with position of
+// upstream units.
+func (s *Chunker) Chunk() Chunk {
+	seq := make([]Chunk, len(s.group.units))
+	for i, u := range s.group.units {
+		seq[i] = u.Chunk
+	}
+
+	c := joinChunks(s.Text(), seq)
+	for i, u := range s.group.units[:s.group.overlap] {
+		if i > 0 {
+			c.Overlap += len(s.separator(u))
+		}
+		c.Overlap += len(u.Text)
+	}
+	return c
+}
@@ -9,6 +9,7 @@
 package scanner_test
 
 import (
+	"bufio"
 	"strings"
 	"testing"
 
@@ -39,3 +40,246 @@ func TestChunker(t *testing.T) {
 		)
 	}
 }
+
+func TestChunkerChunk(t *testing.T) {
+	text := "Hello! World. Next one. Last."
+	s := scanner.NewChunker(10,
+		scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+
+	seq := make([]scanner.Chunk, 0)
+	for s.Scan() {
+		seq = append(seq, s.Chunk())
+	}
+
+	it.Then(t).Should(
+		it.Equal(len(seq), 2),
+		it.Equal(text[seq[0].Start.Offset:seq[0].End.Offset], "Hello! World."),
+		it.Seq(seq[0].Sentences).Equal(0, 1),
+		it.Equal(len(seq[0].Spans), 1),
+		it.Equal(text[seq[1].Start.Offset:seq[1].End.Offset], "Next one. Last."),
+		it.Seq(seq[1].Sentences).Equal(2, 3),
+	)
+}
+
+func TestChunkerChunkUnknown(t *testing.T) {
+	r := bufio.NewScanner(strings.NewReader("Hello!\n\nWorld.\nNext one."))
+	r.Split(bufio.ScanLines)
+	s := scanner.NewChunker(10, r)
+
+	seq := make([]scanner.Chunk, 0)
+	for s.Scan() {
+		seq = append(seq, s.Chunk())
+	}
+
+	it.Then(t).Should(
+		it.Equal(len(seq), 2),
+		it.Equal(seq[0].Text, "Hello!World."),
+		it.Seq(seq[0].Sentences).Equal(0, 1, 2),
+		it.Equal(seq[0].Start, scanner.Position{}),
+		it.Equal(seq[0].End, scanner.Position{}),
+		it.Equal(len(seq[0].Spans), 0),
+		it.Equal(seq[1].Text, "Next one."),
+		it.Equal(seq[1].End, scanner.Position{}),
+	)
+}
+
+func TestChunkerTokens(t *testing.T) {
+	s := scanner.NewChunker(3,
+		scanner.NewSlicer(" ", strings.NewReader("a b c d e f g")),
+	)
+	s.Tokenizer(scanner.NewApprox(4))
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("abc", "def", "g"),
+	)
+}
+
+func TestChunkerTokensCarry(t *testing.T) {
+	s := scanner.NewChunker(3,
+		scanner.NewSlicer(" ", strings.NewReader("a bb.cc d e")),
+	)
+	s.Tokenizer(scanner.NewApprox(4))
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("a", "bb.cc", "de"),
+	)
+}
+
+func TestChunkerHardLimit(t *testing.T) {
+	for input, expected := range map[string][]string{
+		"Hello! World. Next one.": {"Hello!", "World.", "Next one."},
+		"Hi! Hello! World.":       {"Hi!Hello!", "World."},
+		"abcdefghijklmnopqrstuv":  {"abcdefghij", "klmnopqrst", "uv"},
+		"ab. Привет, мир!":        {"ab.", "Приве", "т, мир", "!"},
+	} {
+		s := scanner.NewChunker(10,
+			scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(input)),
+		)
+		s.SizeLimit(scanner.SIZE_LIMIT_HARD)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			it.Then(t).ShouldNot(
+				it.Greater(len(s.Text()), 10),
+			)
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(expected...),
+		)
+	}
+}
+
+func TestChunkerHardLimitChunk(t *testing.T) {
+	text := "Привет, мир!"
+	s := scanner.NewChunker(10,
+		scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.SizeLimit(scanner.SIZE_LIMIT_HARD)
+
+	for s.Scan() {
+		c := s.Chunk()
+		it.Then(t).Should(
+			it.Equal(text[c.Start.Offset:c.End.Offset], c.Text),
+		)
+	}
+}
+
+func TestChunkerMinSize(t *testing.T) {
+	for limit, expected := range map[scanner.SizeLimit][]string{
+		scanner.SIZE_LIMIT_SOFT: {"aaaabbbbc"},
+		scanner.SIZE_LIMIT_HARD: {"aaaabbbb", "c"},
+	} {
+		s := scanner.NewChunker(8,
+			scanner.NewSlicer(" ", strings.NewReader("aaaa bbbb c")),
+		)
+		s.SizeLimit(limit)
+		s.MinSize(2)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(expected...),
+		)
+	}
+}
+
+func TestChunkerOverlap(t *testing.T) {
+	for _, tt := range []struct {
+		n        int
+		in       scanner.OverlapIn
+		expected []string
+	}{
+		{1, scanner.OVERLAP_IN_UNITS, []string{"a;b;", "b;c;", "c;d;", "d;e;"}},
+		{2, scanner.OVERLAP_IN_UNITS, []string{"a;b;", "b;c;", "c;d;", "d;e;"}},
+		{2, scanner.OVERLAP_IN_SIZE, []string{"a;b;", "b;c;", "c;d;", "d;e;"}},
+		{1, scanner.OVERLAP_IN_SIZE, []string{"a;b;", "c;d;", "e;"}},
+	} {
+		s := scanner.NewChunker(4,
+			scanner.NewSlicer(" ", strings.NewReader("a; b; c; d; e;")),
+		)
+		s.SizeLimit(scanner.SIZE_LIMIT_HARD)
+		s.Overlap(tt.n, tt.in)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(tt.expected...),
+		)
+	}
+}
+
+func TestChunkerOverlapChunk(t *testing.T) {
+	s := scanner.NewChunker(6,
+		scanner.NewSlicer(" ", strings.NewReader("aa bb cc dd")),
+	)
+	s.SizeLimit(scanner.SIZE_LIMIT_HARD)
+	s.Overlap(1, scanner.OVERLAP_IN_UNITS)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Equal(s.Text(), "aabbcc"),
+		it.Equal(s.Chunk().Overlap, 0),
+		it.True(s.Scan()),
+		it.Equal(s.Text(), "ccdd"),
+		it.Equal(s.Chunk().Overlap, 2),
+		it.Seq(s.Chunk().Sentences).Equal(2, 3),
+	)
+
+	it.Then(t).ShouldNot(
+		it.True(s.Scan()),
+	)
+}
+
+func TestChunkerJoiner(t *testing.T) {
+	s := scanner.NewChunker(10,
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader("Hello! World. Next one.")),
+	)
+	s.Joiner(" ")
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("Hello! World.", "Next one."),
+	)
+}
+
+func TestChunkerJoinSource(t *testing.T) {
+	for _, input := range []string{
+		"Hello!  World.\nNext one.\n\nLast one. And the final one.",
+		"  Hello! World.",
+	} {
+		for _, limit := range []scanner.SizeLimit{scanner.SIZE_LIMIT_SOFT, scanner.SIZE_LIMIT_HARD} {
+			s := scanner.NewChunker(12,
+				scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(input)),
+			)
+			s.SizeLimit(limit)
+			s.JoinSource()
+
+			for s.Scan() {
+				c := s.Chunk()
+				it.Then(t).Should(
+					it.Equal(input[c.Start.Offset:c.End.Offset], s.Text()),
+				)
+			}
+		}
+	}
+}
+
+func TestChunkerJoinSourceSlicer(t *testing.T) {
+	s := scanner.NewChunker(5,
+		scanner.NewSlices("\n", strings.NewReader("a\nb\nc\nd")),
+	)
+	s.SizeLimit(scanner.SIZE_LIMIT_HARD)
+	s.JoinSource()
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("a\nb\nc", "d"),
+	)
+}
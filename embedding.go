@@ -19,6 +19,12 @@ type Embedder interface {
 	Embedding(ctx context.Context, text string) ([]float32, int, error)
 }
 
+// Utility for embedding vectors calculation in batches. Scanners detect
+// the interface and embed the context window with a single call.
+type BatchEmbedder interface {
+	Embeddings(ctx context.Context, text []string) ([][]float32, int, error)
+}
+
 // Configure similarity sorting algorithm
 type SimilarityWith int
 
@@ -74,6 +80,45 @@ func CosineSimilarity(f func(float32) bool) func(a, b []float32) bool {
 	}
 }
 
+// Centroid of vectors, it is the mean vector optionally normalized to
+// the unit length.
+func Centroid(vectors [][]float32, normalize bool) []float32 {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	c := make([]float32, len(vectors[0]))
+	for _, v := range vectors {
+		if len(v) != len(c) {
+			panic("vectors must have equal lengths")
+		}
+
+		for i, x := range v {
+			c[i] += x
+		}
+	}
+
+	n := float32(len(vectors))
+	for i := range c {
+		c[i] /= n
+	}
+
+	if normalize {
+		norm := float32(0.0)
+		for _, x := range c {
+			norm += x * x
+		}
+
+		if norm = math32.Sqrt(norm); norm > 0 {
+			for i := range c {
+				c[i] /= norm
+			}
+		}
+	}
+
+	return c
+}
+
 func cosine(a, b []float32) (d float32) {
 	if len(a) != len(b) {
 		panic("vectors must have equal lengths")
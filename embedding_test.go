@@ -11,6 +11,7 @@ package scanner_test
 import (
 	"testing"
 
+	"github.com/fogfish/it/v2"
 	"github.com/fogfish/scanner"
 )
 
@@ -332,3 +333,16 @@ func TestCosineSimilarityPanicCases(t *testing.T) {
 		scanner.HighSimilarity([]float32{1.0, 1.0, 1.0}, []float32{1.0, 1.0, 1.0})
 	})
 }
+
+func TestCentroid(t *testing.T) {
+	vectors := [][]float32{
+		{1.0, 0.0, 0.0, 0.0},
+		{0.0, 1.0, 0.0, 0.0},
+	}
+
+	it.Then(t).Should(
+		it.Seq(scanner.Centroid(vectors, false)).Equal(0.5, 0.5, 0.0, 0.0),
+		it.Seq(scanner.Centroid(vectors, true)).Equal(0.70710677, 0.70710677, 0.0, 0.0),
+		it.Equal(len(scanner.Centroid(nil, true)), 0),
+	)
+}
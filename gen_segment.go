@@ -0,0 +1,276 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+//go:build ignore
+
+// Generates break tables of Unicode Text Segmentation (UAX #29) from
+// files of Unicode Character Database (see ucd/README.md).
+//
+//	go run gen_segment.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const output = "segment_tables.go"
+
+// property value of the file mapped to the break property of the scanner
+type source struct {
+	file     string
+	property string // the property name, if the file defines many
+	values   map[string]string
+}
+
+var (
+	gcb = source{
+		file: "GraphemeBreakProperty.txt",
+		values: map[string]string{
+			"CR":                 "propCR",
+			"LF":                 "propLF",
+			"Control":            "propControl",
+			"Extend":             "propExtend",
+			"ZWJ":                "propZWJ",
+			"Regional_Indicator": "propRI",
+			"Prepend":            "propPrepend",
+			"SpacingMark":        "propSpacingMark",
+			"L":                  "propL",
+			"V":                  "propV",
+			"T":                  "propT",
+			"LV":                 "propLV",
+			"LVT":                "propLVT",
+		},
+	}
+
+	incb = source{
+		file:     "DerivedCoreProperties.txt",
+		property: "InCB",
+		values: map[string]string{
+			"Consonant": "propConsonant",
+			"Linker":    "propLinker",
+			"Extend":    "propConjunct",
+		},
+	}
+
+	extPict = source{
+		file:     "emoji-data.txt",
+		property: "Extended_Pictographic",
+		values: map[string]string{
+			"Extended_Pictographic": "propExtPict",
+		},
+	}
+
+	wb = source{
+		file: "WordBreakProperty.txt",
+		values: map[string]string{
+			"CR":                 "propCR",
+			"LF":                 "propLF",
+			"Newline":            "propNewline",
+			"Extend":             "propExtend",
+			"ZWJ":                "propZWJ",
+			"Regional_Indicator": "propRI",
+			"Format":             "propFormat",
+			"Katakana":           "propKatakana",
+			"Hebrew_Letter":      "propHebrew",
+			"ALetter":            "propALetter",
+			"Single_Quote":       "propSingleQuote",
+			"Double_Quote":       "propDoubleQuote",
+			"MidNumLet":          "propMidNumLet",
+			"MidLetter":          "propMidLetter",
+			"MidNum":             "propMidNum",
+			"Numeric":            "propNumeric",
+			"ExtendNumLet":       "propExtendNumLet",
+			"WSegSpace":          "propWSegSpace",
+		},
+	}
+
+	sb = source{
+		file: "SentenceBreakProperty.txt",
+		values: map[string]string{
+			"CR":        "propCR",
+			"LF":        "propLF",
+			"Extend":    "propExtend",
+			"Sep":       "propSep",
+			"Format":    "propFormat",
+			"Sp":        "propSp",
+			"Lower":     "propLower",
+			"Upper":     "propUpper",
+			"OLetter":   "propOLetter",
+			"Numeric":   "propNumeric",
+			"ATerm":     "propATerm",
+			"SContinue": "propSContinue",
+			"STerm":     "propSTerm",
+			"Close":     "propClose",
+		},
+	}
+)
+
+var tables = []struct {
+	name    string
+	comment string
+	sources []source
+}{
+	{"graphemeTable", "Grapheme_Cluster_Break, Indic_Conjunct_Break and Extended_Pictographic properties", []source{gcb, incb, extPict}},
+	{"wordTable", "Word_Break and Extended_Pictographic properties", []source{wb, extPict}},
+	{"sentenceTable", "Sentence_Break property", []source{sb}},
+}
+
+func main() {
+	var version string
+	var out bytes.Buffer
+
+	body := new(bytes.Buffer)
+	for _, t := range tables {
+		props := map[rune][]string{}
+		for _, src := range t.sources {
+			v, err := load(src, props)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if version == "" {
+				version = v
+			}
+		}
+
+		fmt.Fprintf(body, "\n// %s\nvar %s = []propRange{\n", t.comment, t.name)
+		for _, r := range ranges(props) {
+			fmt.Fprintf(body, "\t{0x%04X, 0x%04X, %s},\n", r.lo, r.hi, r.prop)
+		}
+		fmt.Fprintf(body, "}\n")
+	}
+
+	fmt.Fprintf(&out, "// Code generated by gen_segment.go from Unicode Character Database %s. DO NOT EDIT.\n\n", version)
+	fmt.Fprintf(&out, "package scanner\n")
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load property values of the file, it returns the Unicode version
+func load(src source, props map[rune][]string) (string, error) {
+	f, err := os.Open(filepath.Join("ucd", src.file))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	version := ""
+	lines := bufio.NewScanner(f)
+	for lines.Scan() {
+		line := lines.Text()
+		if version == "" && strings.HasPrefix(line, "# ") && strings.HasSuffix(line, ".txt") {
+			// # GraphemeBreakProperty-17.0.0.txt
+			if at := strings.LastIndexByte(line, '-'); at > 0 {
+				version = strings.TrimSuffix(line[at+1:], ".txt")
+			}
+		}
+
+		if at := strings.IndexByte(line, '#'); at >= 0 {
+			line = line[:at]
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		value := fields[1]
+		if src.property != "" {
+			if fields[1] != src.property {
+				continue
+			}
+			if len(fields) > 2 {
+				value = fields[2]
+			}
+		}
+
+		prop, has := src.values[value]
+		if !has {
+			if src.property == "" {
+				return "", fmt.Errorf("%s: unknown value %s", src.file, value)
+			}
+			continue
+		}
+
+		lo, hi, err := codepoints(fields[0])
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", src.file, err)
+		}
+
+		for r := lo; r <= hi; r++ {
+			props[r] = append(props[r], prop)
+		}
+	}
+
+	return version, lines.Err()
+}
+
+// parse 0041 or 0041..005A
+func codepoints(s string) (rune, rune, error) {
+	lo, hi, _ := strings.Cut(s, "..")
+	if hi == "" {
+		hi = lo
+	}
+
+	a, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	b, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return rune(a), rune(b), nil
+}
+
+type propRange struct {
+	lo, hi rune
+	prop   string
+}
+
+// merge adjacent runes with same properties into ranges
+func ranges(props map[rune][]string) []propRange {
+	runes := make([]rune, 0, len(props))
+	for r := range props {
+		runes = append(runes, r)
+	}
+	slices.Sort(runes)
+
+	seq := make([]propRange, 0)
+	for _, r := range runes {
+		prop := strings.Join(props[r], " | ")
+		if n := len(seq); n > 0 && seq[n-1].hi == r-1 && seq[n-1].prop == prop {
+			seq[n-1].hi = r
+			continue
+		}
+		seq = append(seq, propRange{lo: r, hi: r, prop: prop})
+	}
+
+	return seq
+}
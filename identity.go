@@ -8,25 +8,186 @@
 
 package scanner
 
-import "io"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+// ErrTooLarge is returned if the input exceeds the max size of [Identity].
+var ErrTooLarge = errors.New("input is too large")
+
+// ReadError is returned if reading of input has failed, it reports
+// the number of bytes consumed before the failure.
+type ReadError struct {
+	Read int
+	Err  error
+}
+
+func (e *ReadError) Error() string {
+	return fmt.Sprintf("read has failed after %d bytes: %s", e.Read, e.Err)
+}
+
+func (e *ReadError) Unwrap() error { return e.Err }
+
+// Configure handling of input exceeding the max size
+type Overflow int
+
+// Configure handling of input exceeding the max size
+const (
+	OVERFLOW_FAIL Overflow = iota
+	OVERFLOW_TRUNCATE
+	OVERFLOW_SPLIT
+)
 
 func NewIdentity(r io.Reader) *Identity {
-	return &Identity{Reader: r}
+	return &Identity{Reader: r, pos: origin, seq: -1}
 }
 
 type Identity struct {
 	io.Reader
-	err error
-	txt []byte
+	err          error
+	txt          []byte
+	maxSize      int
+	confOverflow Overflow
+	read         int
+	carry        []byte
+	eof          bool
+	pos          Position
+	span         Span
+	seq          int
+	para         paragraphs
+}
+
+// MaxSize sets the max size of input in bytes, the input is read
+// incrementally and never buffered beyond the limit. The default value
+// is 0, the size is unlimited.
+func (r *Identity) MaxSize(n int) {
+	r.maxSize = n
+}
+
+// Overflow sets the handling of input exceeding the max size.
+//
+// Using OVERFLOW_FAIL stops the scan with [ErrTooLarge], it is the default
+// behavior.
+//
+// Using OVERFLOW_TRUNCATE emits the input truncated at the rune boundary
+// within the limit. The input is consumed up to max size plus one byte,
+// which detects the overflow, the remaining input is not read.
+//
+// Using OVERFLOW_SPLIT emits the input as pieces of max size, split at
+// rune boundaries, similarly to [Chunker] with SIZE_LIMIT_HARD.
+func (r *Identity) Overflow(x Overflow) {
+	r.confOverflow = x
 }
 
 func (r *Identity) Err() error   { return r.err }
 func (r *Identity) Text() string { return string(r.txt) }
+
+// Chunk returns the input annotated with position, pieces of the input
+// (see OVERFLOW_SPLIT) are numbered as sentences.
+func (r *Identity) Chunk() Chunk {
+	return Chunk{Span: r.span, Text: r.Text(), Spans: []Span{r.span}, Sentences: []int{r.seq}}
+}
+
 func (r *Identity) Scan() bool {
-	if r.err != nil || r.txt != nil {
+	if r.err != nil || r.eof {
+		return false
+	}
+
+	if r.maxSize <= 0 {
+		r.txt, r.err = r.fill(nil, -1)
+		r.eof = true
+		r.annotate()
+		return r.err == nil
+	}
+
+	// one byte over the limit detects the overflow
+	buf, err := r.fill(r.carry, r.maxSize+1)
+	r.carry = nil
+	if err != nil {
+		r.err = err
 		return false
 	}
 
-	r.txt, r.err = io.ReadAll(r.Reader)
-	return r.err == nil
+	if len(buf) <= r.maxSize {
+		r.eof = true
+		if len(buf) == 0 && r.span.End.Offset > 0 {
+			return false
+		}
+
+		r.txt = buf
+		r.annotate()
+		return true
+	}
+
+	switch r.confOverflow {
+	case OVERFLOW_TRUNCATE:
+		r.eof = true
+		r.txt = buf[:cutRune(buf, r.maxSize)]
+	case OVERFLOW_SPLIT:
+		n := cutRune(buf, r.maxSize)
+		r.txt, r.carry = buf[:n], append([]byte(nil), buf[n:]...)
+	default:
+		r.txt = nil
+		r.err = ErrTooLarge
+		return false
+	}
+
+	r.annotate()
+	return true
+}
+
+// read input up to n bytes, the entire input is read if n < 0. It returns
+// less than n bytes only at the end of input.
+func (r *Identity) fill(buf []byte, n int) ([]byte, error) {
+	if buf == nil {
+		buf = make([]byte, 0, 512)
+	}
+
+	for n < 0 || len(buf) < n {
+		if len(buf) == cap(buf) {
+			buf = append(buf, 0)[:len(buf)]
+		}
+
+		end := cap(buf)
+		if n >= 0 {
+			end = min(end, n)
+		}
+
+		k, err := r.Reader.Read(buf[len(buf):end])
+		buf = buf[:len(buf)+k]
+		r.read += k
+
+		switch {
+		case err == io.EOF:
+			return buf, nil
+		case err != nil:
+			return buf, &ReadError{Read: r.read, Err: err}
+		}
+	}
+
+	return buf, nil
+}
+
+// annotate the recent text with position
+func (r *Identity) annotate() {
+	r.span.Start = r.pos
+	r.pos = forward(r.pos, r.txt)
+	r.pos.Paragraph = r.para.forward(r.txt)
+	r.span.End = r.pos
+	r.seq++
+}
+
+// length of data cut at the rune boundary within n bytes, the first rune
+// is taken as-is if it is longer than n.
+func cutRune(data []byte, n int) int {
+	for n < len(data) && n > 0 && !utf8.RuneStart(data[n]) {
+		n--
+	}
+	if n == 0 {
+		_, n = utf8.DecodeRune(data)
+	}
+	return min(n, len(data))
 }
@@ -0,0 +1,105 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestIdentityChunk(t *testing.T) {
+	s := scanner.NewIdentity(strings.NewReader("Hello\nWorld"))
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Equal(s.Chunk().End, scanner.Position{Offset: 11, Rune: 11, Line: 2, Column: 6}),
+	)
+}
+
+func TestIdentityMaxSize(t *testing.T) {
+	text := "Hello\n\nМир!"
+
+	t.Run("Fail", func(t *testing.T) {
+		s := scanner.NewIdentity(strings.NewReader(text))
+		s.MaxSize(8)
+
+		it.Then(t).Should(
+			it.True(!s.Scan()),
+			it.Equal(s.Err(), scanner.ErrTooLarge),
+		)
+	})
+
+	t.Run("Fits", func(t *testing.T) {
+		s := scanner.NewIdentity(strings.NewReader(text))
+		s.MaxSize(len(text))
+
+		it.Then(t).Should(
+			it.True(s.Scan()),
+			it.Equal(s.Text(), text),
+			it.True(!s.Scan()),
+			it.Nil(s.Err()),
+		)
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		r := strings.NewReader(text)
+		s := scanner.NewIdentity(r)
+		s.MaxSize(10)
+		s.Overflow(scanner.OVERFLOW_TRUNCATE)
+
+		it.Then(t).Should(
+			it.True(s.Scan()),
+			it.Equal(s.Text(), "Hello\n\nМ"),
+			it.True(!s.Scan()),
+			it.Nil(s.Err()),
+			it.Equal(r.Len(), len(text)-11),
+		)
+	})
+
+	t.Run("Split", func(t *testing.T) {
+		s := scanner.NewIdentity(strings.NewReader(text))
+		s.MaxSize(8)
+		s.Overflow(scanner.OVERFLOW_SPLIT)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			c := s.Chunk()
+			it.Then(t).Should(
+				it.Equal(text[c.Start.Offset:c.End.Offset], c.Text),
+				it.Seq(c.Sentences).Equal(len(seq)),
+			)
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Nil(s.Err()),
+			it.Seq(seq).Equal("Hello\n\n", "Мир!"),
+			it.Equal(s.Chunk().Start.Paragraph, 1),
+		)
+	})
+
+	t.Run("ReadError", func(t *testing.T) {
+		fail := errors.New("connection reset")
+		s := scanner.NewIdentity(io.MultiReader(strings.NewReader(text), iotest.ErrReader(fail)))
+
+		var err *scanner.ReadError
+		it.Then(t).Should(
+			it.True(!s.Scan()),
+			it.True(errors.As(s.Err(), &err)),
+			it.Equal(err.Read, len(text)),
+			it.True(errors.Is(s.Err(), fail)),
+		)
+	})
+}
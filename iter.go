@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"iter"
+
+	"github.com/fogfish/golem/optics"
+	"github.com/fogfish/golem/trait/seq"
+)
+
+// Texts returns the iterator over tokens of the scanner. Use [Scanner.Err]
+// to check errors once the iteration is over. The iterator stops the source
+// created by the library (see [FromSeq]) once the iteration is over or
+// broken, the reader of the scanner is never closed.
+func Texts(s Scanner) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		defer release(s)
+		for s.Scan() {
+			if !yield(s.Text()) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns the iterator over tokens of the scanner annotated with
+// position (e.g. [Splitter], [Chunker] or [Semantic]). The error of scanner
+// is yielded with empty chunk at the end of iteration. The source is
+// released as by [Texts].
+func Chunks(s interface {
+	Scan() bool
+	Chunk() Chunk
+	Err() error
+}) iter.Seq2[Chunk, error] {
+	return func(yield func(Chunk, error) bool) {
+		defer release(s)
+		for s.Scan() {
+			if !yield(s.Chunk(), nil) {
+				return
+			}
+		}
+
+		if err := s.Err(); err != nil {
+			yield(Chunk{}, err)
+		}
+	}
+}
+
+// Groups returns the iterator over semantic chunks as sentences. Use
+// [Semantic.Err] to check errors once the iteration is over. The source is
+// released as by [Texts].
+func Groups(s *Semantic) iter.Seq[[]string] {
+	return func(yield func([]string) bool) {
+		defer release(s)
+		for s.Scan() {
+			if !yield(s.Text()) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns the iterator over semantically sorted groups. Use
+// [Sorter.Err] to check errors once the iteration is over. The source is
+// released as by [Texts].
+func Sorted[T any](s *Sorter[T]) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		defer release(s)
+		for s.Next() {
+			if !yield(s.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Source is the scanner over the iterator, the source of [NewSemantic],
+// [NewChunker] and [NewSorter] (see [Source.Seq]).
+type Source struct {
+	next func() (string, bool)
+	stop func()
+	text string
+}
+
+// Create a scanner that pulls tokens from the iterator.
+func FromSeq(seq iter.Seq[string]) *Source {
+	next, stop := iter.Pull(seq)
+	return &Source{next: next, stop: stop}
+}
+
+func (s *Source) Err() error   { return nil }
+func (s *Source) Text() string { return s.text }
+
+func (s *Source) Scan() bool {
+	if s.next == nil {
+		return false
+	}
+
+	text, ok := s.next()
+	if !ok {
+		s.Close()
+		return false
+	}
+
+	s.text = text
+	return true
+}
+
+// Close stops the iterator, it is safe to call it multiple times.
+func (s *Source) Close() error {
+	s.release()
+	return nil
+}
+
+func (s *Source) release() {
+	if s.stop != nil {
+		s.stop()
+		s.next, s.stop = nil, nil
+	}
+}
+
+// Seq returns remaining tokens as the sequence (e.g. source of [NewSorter]
+// with [Self] lens), it is nil if there are no tokens.
+func (s *Source) Seq() seq.Seq[string] {
+	if !s.Scan() {
+		return nil
+	}
+
+	return sourceSeq{s}
+}
+
+type sourceSeq struct{ *Source }
+
+func (s sourceSeq) Value() string { return s.Text() }
+func (s sourceSeq) Next() bool    { return s.Scan() }
+
+// Self is the lens focusing on the string itself.
+func Self() optics.Lens[string, string] { return self{} }
+
+type self struct{}
+
+func (self) Get(s *string) string            { return *s }
+func (self) Put(s *string, v string) *string { *s = v; return s }
+
+// Scanner that holds resources created by the library
+type releaser interface {
+	release()
+}
+
+// stops resources created by the library, resources of the caller
+// (e.g. io.Reader) are not released.
+func release(x any) {
+	if r, ok := x.(releaser); ok {
+		r.release()
+	}
+}
@@ -0,0 +1,181 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"errors"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+type closer struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closer) Close() error {
+	c.closed = true
+	return nil
+}
+
+// sequence that reports when it is stopped
+func texts(stopped *bool, seq ...string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		defer func() { *stopped = true }()
+		for _, x := range seq {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+func TestTexts(t *testing.T) {
+	r := &closer{Reader: strings.NewReader("a. bb. c.")}
+	s := scanner.NewSentences(scanner.EndOfSentence, r)
+
+	seq := make([]string, 0)
+	for text := range scanner.Texts(s) {
+		seq = append(seq, text)
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("a.", "bb.", "c."),
+		it.True(!r.closed),
+	)
+}
+
+func TestTextsBreak(t *testing.T) {
+	r := &closer{Reader: strings.NewReader("a. bb. c.")}
+	s := scanner.NewSentences(scanner.EndOfSentence, r)
+
+	for range scanner.Texts(s) {
+		break
+	}
+
+	it.Then(t).Should(
+		it.True(!r.closed),
+	)
+}
+
+func TestChunks(t *testing.T) {
+	text := "a. bb. c."
+	s := scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(text))
+
+	seq := make([]string, 0)
+	for c, err := range scanner.Chunks(s) {
+		it.Then(t).Should(
+			it.Nil(err),
+			it.Equal(text[c.Start.Offset:c.End.Offset], c.Text),
+		)
+		seq = append(seq, c.Text)
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("a.", "bb.", "c."),
+	)
+}
+
+func TestChunksError(t *testing.T) {
+	fail := errors.New("connection reset")
+	s := scanner.NewIdentity(iotest.ErrReader(fail))
+
+	var err error
+	for _, err = range scanner.Chunks(s) {
+	}
+
+	it.Then(t).Should(
+		it.True(errors.Is(err, fail)),
+	)
+}
+
+func TestChunksBreak(t *testing.T) {
+	stopped := false
+	s := scanner.NewChunker(5, scanner.FromSeq(texts(&stopped, "a.", "bb.", "c.", "ddd.")))
+
+	for range scanner.Chunks(s) {
+		break
+	}
+
+	it.Then(t).Should(
+		it.True(stopped),
+	)
+}
+
+func TestFromSeq(t *testing.T) {
+	stopped := false
+	s := scanner.NewChunker(5, scanner.FromSeq(texts(&stopped, "a.", "bb.", "c.", "ddd.")))
+	s.Joiner(" ")
+
+	it.Then(t).Should(
+		it.Seq(slices.Collect(scanner.Texts(s))).Equal("a. bb.", "c. ddd."),
+		it.True(stopped),
+	)
+}
+
+func TestFromSeqBreak(t *testing.T) {
+	stopped := false
+	s := scanner.NewChunker(5, scanner.FromSeq(texts(&stopped, "a.", "bb.", "c.", "ddd.")))
+	s.Joiner(" ")
+
+	for range scanner.Texts(s) {
+		break
+	}
+
+	it.Then(t).Should(
+		it.True(stopped),
+	)
+}
+
+func TestGroups(t *testing.T) {
+	stopped := false
+	s := scanner.NewSemantic(embed{},
+		scanner.FromSeq(texts(&stopped, "a.", "bb.", "c.", "ddd.", "ff.")),
+	)
+	s.Similarity(similar)
+	s.Window(3)
+
+	seq := make([][]string, 0)
+	for group := range scanner.Groups(s) {
+		seq = append(seq, group)
+		break
+	}
+
+	it.Then(t).Should(
+		it.Equal(len(seq), 1),
+		it.Seq(seq[0]).Equal("a.", "c."),
+		it.True(stopped),
+	)
+}
+
+func TestSorted(t *testing.T) {
+	stopped := false
+	s := scanner.NewSorter(embed{}, scanner.Self(),
+		scanner.FromSeq(texts(&stopped, "a.", "bb.", "c.", "ddd.", "ff.")).Seq(),
+	)
+	s.Similarity(similar)
+	s.Window(3)
+
+	seq := slices.Collect(scanner.Sorted(s))
+
+	it.Then(t).Should(
+		it.Equal(len(seq), 3),
+		it.Seq(seq[0]).Equal("a.", "c."),
+		it.Seq(seq[1]).Equal("bb.", "ff."),
+		it.Seq(seq[2]).Equal("ddd."),
+		it.True(stopped),
+	)
+}
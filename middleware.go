@@ -0,0 +1,399 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// Clock abstracts the time for Embedder middleware.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// sleep for the duration unless the context is cancelled
+func sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	select {
+	case <-clock.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+//------------------------------------------------------------------------------
+
+// TransientError is recoverable error, the call might succeed if retried.
+type TransientError struct{ Err error }
+
+func (e *TransientError) Error() string { return e.Err.Error() }
+func (e *TransientError) Unwrap() error { return e.Err }
+
+// Transient marks the error as recoverable.
+func Transient(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &TransientError{Err: err}
+}
+
+// IsTransient classifies the error as recoverable. The error is transient if
+// it is marked by [Transient] or implements `Temporary() bool` or
+// `Timeout() bool` behavior, which returns true.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var transient *TransientError
+	if errors.As(err, &transient) {
+		return true
+	}
+
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) && temporary.Temporary() {
+		return true
+	}
+
+	var timeout interface{ Timeout() bool }
+	if errors.As(err, &timeout) && timeout.Timeout() {
+		return true
+	}
+
+	return false
+}
+
+//------------------------------------------------------------------------------
+
+// Retry is Embedder middleware that retries transient errors with
+// exponential backoff and full jitter.
+type Retry struct {
+	embed     Embedder
+	clock     Clock
+	attempts  int
+	base      time.Duration
+	cap       time.Duration
+	transient func(error) bool
+}
+
+var (
+	_ Embedder      = (*Retry)(nil)
+	_ BatchEmbedder = (*Retry)(nil)
+)
+
+// Creates new instance of retrying Embedder. By default, it makes 5 attempts
+// with backoff from 100ms up to 10s, errors are classified by [IsTransient].
+func NewRetry(embed Embedder) *Retry {
+	return &Retry{
+		embed:     embed,
+		clock:     systemClock{},
+		attempts:  5,
+		base:      100 * time.Millisecond,
+		cap:       10 * time.Second,
+		transient: IsTransient,
+	}
+}
+
+// Attempts defines the maximum number of attempts.
+func (r *Retry) Attempts(n int) { r.attempts = n }
+
+// Backoff defines base and maximum delay between attempts. The delay is
+// randomly chosen from [0, min(cap, base·2ⁿ)) for n-th retry.
+func (r *Retry) Backoff(base, cap time.Duration) { r.base, r.cap = base, cap }
+
+// Classify defines the classifier of transient errors.
+func (r *Retry) Classify(f func(error) bool) { r.transient = f }
+
+// Clock defines the clock used to delay attempts.
+func (r *Retry) Clock(clock Clock) { r.clock = clock }
+
+func (r *Retry) Embedding(ctx context.Context, text string) ([]float32, int, error) {
+	var v32 []float32
+	tokens, err := r.retry(ctx, func() (used int, err error) {
+		v32, used, err = r.embed.Embedding(ctx, text)
+		return
+	})
+
+	return v32, tokens, err
+}
+
+// Embeddings retries the batch as a whole.
+func (r *Retry) Embeddings(ctx context.Context, text []string) ([][]float32, int, error) {
+	var vectors [][]float32
+	tokens, err := r.retry(ctx, func() (used int, err error) {
+		vectors, used, err = batchOf(ctx, r.embed, text)
+		return
+	})
+
+	return vectors, tokens, err
+}
+
+func (r *Retry) batches() bool { return batches(r.embed) }
+
+// retry the call, tokens used by each attempt are aggregated
+func (r *Retry) retry(ctx context.Context, call func() (int, error)) (int, error) {
+	tokens := 0
+	for n := 0; ; n++ {
+		used, err := call()
+		tokens += used
+		if err == nil || n+1 >= r.attempts || !r.transient(err) {
+			return tokens, err
+		}
+
+		if err := sleep(ctx, r.clock, r.backoff(n)); err != nil {
+			return tokens, err
+		}
+	}
+}
+
+func (r *Retry) backoff(n int) time.Duration {
+	d := r.cap
+	if n < 62 && r.base<<n > 0 && r.base<<n < r.cap {
+		d = r.base << n
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	return rand.N(d)
+}
+
+//------------------------------------------------------------------------------
+
+// RateLimit is Embedder middleware that limits rate of requests and
+// rate of used tokens using token bucket algorithm. Token counts are known
+// after the call only, the limiter delays subsequent requests until
+// the debt is repaid.
+type RateLimit struct {
+	embed    Embedder
+	clock    Clock
+	mu       sync.Mutex
+	requests *bucket
+	tokens   *bucket
+}
+
+var (
+	_ Embedder      = (*RateLimit)(nil)
+	_ BatchEmbedder = (*RateLimit)(nil)
+)
+
+// Creates new instance of rate limited Embedder, it allows rps requests per
+// second with bursts of at most burst requests.
+func NewRateLimit(embed Embedder, rps float64, burst int) *RateLimit {
+	clock := systemClock{}
+	return &RateLimit{
+		embed:    embed,
+		clock:    clock,
+		requests: newBucket(rps, burst, clock.Now()),
+	}
+}
+
+// Tokens limits the rate of used tokens, it allows tps tokens per second
+// with bursts of at most burst tokens.
+func (r *RateLimit) Tokens(tps float64, burst int) {
+	r.tokens = newBucket(tps, burst, r.clock.Now())
+}
+
+// Clock defines the clock used by the rate limiter.
+func (r *RateLimit) Clock(clock Clock) {
+	r.clock = clock
+	r.requests.at = clock.Now()
+	if r.tokens != nil {
+		r.tokens.at = clock.Now()
+	}
+}
+
+func (r *RateLimit) Embedding(ctx context.Context, text string) ([]float32, int, error) {
+	if err := r.acquire(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	v32, used, err := r.embed.Embedding(ctx, text)
+	r.release(used)
+
+	return v32, used, err
+}
+
+// Embeddings counts the batch as a single request.
+func (r *RateLimit) Embeddings(ctx context.Context, text []string) ([][]float32, int, error) {
+	if err := r.acquire(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	vectors, used, err := batchOf(ctx, r.embed, text)
+	r.release(used)
+
+	return vectors, used, err
+}
+
+func (r *RateLimit) batches() bool { return batches(r.embed) }
+
+// wait for the request to be allowed
+func (r *RateLimit) acquire(ctx context.Context) error {
+	r.mu.Lock()
+	now := r.clock.Now()
+	wait := r.requests.take(now, 1)
+	if r.tokens != nil {
+		wait = max(wait, r.tokens.take(now, 0))
+	}
+	r.mu.Unlock()
+
+	return sleep(ctx, r.clock, wait)
+}
+
+// take tokens used by the request
+func (r *RateLimit) release(used int) {
+	if r.tokens != nil && used > 0 {
+		r.mu.Lock()
+		r.tokens.take(r.clock.Now(), float64(used))
+		r.mu.Unlock()
+	}
+}
+
+// token bucket, the level is allowed to be negative (debt)
+type bucket struct {
+	rate  float64
+	burst float64
+	level float64
+	at    time.Time
+}
+
+func newBucket(rate float64, burst int, now time.Time) *bucket {
+	return &bucket{rate: rate, burst: float64(burst), level: float64(burst), at: now}
+}
+
+// take n tokens from the bucket, returns the delay until the level is
+// non-negative.
+func (b *bucket) take(now time.Time, n float64) time.Duration {
+	if now.After(b.at) {
+		b.level = min(b.burst, b.level+b.rate*now.Sub(b.at).Seconds())
+		b.at = now
+	}
+
+	b.level -= n
+	if b.level >= 0 || b.rate <= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.level / b.rate * float64(time.Second))
+}
+
+//------------------------------------------------------------------------------
+
+// ErrCircuitOpen is returned by the circuit breaker while it is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreaker is Embedder middleware that stops calling the embedder after
+// consecutive failures. The circuit is opened for the cooldown period, then
+// a single probe request is allowed. The circuit is closed again if the probe
+// succeeds.
+type CircuitBreaker struct {
+	embed     Embedder
+	clock     Clock
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openedAt  time.Time
+	probing   bool
+}
+
+var (
+	_ Embedder      = (*CircuitBreaker)(nil)
+	_ BatchEmbedder = (*CircuitBreaker)(nil)
+)
+
+// Creates new instance of Embedder protected by circuit breaker, the circuit
+// is opened after threshold of consecutive failures for cooldown period.
+func NewCircuitBreaker(embed Embedder, threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		embed:     embed,
+		clock:     systemClock{},
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// Clock defines the clock used by the circuit breaker.
+func (cb *CircuitBreaker) Clock(clock Clock) { cb.clock = clock }
+
+func (cb *CircuitBreaker) Embedding(ctx context.Context, text string) ([]float32, int, error) {
+	if err := cb.acquire(); err != nil {
+		return nil, 0, err
+	}
+
+	v32, used, err := cb.embed.Embedding(ctx, text)
+	cb.release(err)
+
+	return v32, used, err
+}
+
+// Embeddings calls the embedder with the batch as a single request.
+func (cb *CircuitBreaker) Embeddings(ctx context.Context, text []string) ([][]float32, int, error) {
+	if err := cb.acquire(); err != nil {
+		return nil, 0, err
+	}
+
+	vectors, used, err := batchOf(ctx, cb.embed, text)
+	cb.release(err)
+
+	return vectors, used, err
+}
+
+func (cb *CircuitBreaker) batches() bool { return batches(cb.embed) }
+
+func (cb *CircuitBreaker) acquire() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.failures < cb.threshold {
+		return nil
+	}
+
+	if cb.probing || cb.clock.Now().Sub(cb.openedAt) < cb.cooldown {
+		return ErrCircuitOpen
+	}
+
+	cb.probing = true
+	return nil
+}
+
+func (cb *CircuitBreaker) release(err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+
+	switch {
+	case err == nil:
+		cb.failures = 0
+	case errors.Is(err, context.Canceled):
+		// cancellation is not a failure of embedder
+	default:
+		cb.failures++
+		if cb.failures >= cb.threshold {
+			cb.openedAt = cb.clock.Now()
+		}
+	}
+}
@@ -0,0 +1,274 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestRetry(t *testing.T) {
+	clock := &fakeClock{}
+	api := &flaky{fails: 2, err: scanner.Transient(errors.New("throttled"))}
+
+	r := scanner.NewRetry(api)
+	r.Backoff(time.Second, 3*time.Second)
+	r.Clock(clock)
+
+	v, n, err := r.Embedding(context.Background(), "bb.")
+
+	it.Then(t).Should(
+		it.Nil(err),
+		it.Seq(v).Equal(0, 1, 0, 0),
+		it.Equal(n, 3),
+		it.Equal(api.calls, 3),
+		it.Equal(len(clock.sleeps), 2),
+		it.Less(clock.sleeps[0], time.Second),
+		it.Less(clock.sleeps[1], 2*time.Second),
+	)
+}
+
+func TestRetryAttempts(t *testing.T) {
+	api := &flaky{fails: 10, err: scanner.Transient(errors.New("throttled"))}
+
+	r := scanner.NewRetry(api)
+	r.Attempts(3)
+	r.Clock(&fakeClock{})
+
+	_, _, err := r.Embedding(context.Background(), "bb.")
+
+	it.Then(t).Should(
+		it.Fail(func() error { return err }).Contain("throttled"),
+		it.Equal(api.calls, 3),
+	)
+}
+
+func TestRetryPermanent(t *testing.T) {
+	api := &flaky{fails: 10, err: errors.New("invalid")}
+
+	r := scanner.NewRetry(api)
+	r.Clock(&fakeClock{})
+
+	_, _, err := r.Embedding(context.Background(), "bb.")
+
+	it.Then(t).Should(
+		it.Fail(func() error { return err }).Contain("invalid"),
+		it.Equal(api.calls, 1),
+	)
+}
+
+func TestRateLimit(t *testing.T) {
+	clock := &fakeClock{}
+
+	r := scanner.NewRateLimit(topic{}, 1, 2)
+	r.Clock(clock)
+
+	for range 4 {
+		r.Embedding(context.Background(), "bb.")
+	}
+
+	it.Then(t).Should(
+		it.Seq(clock.sleeps).Equal(time.Second, time.Second),
+	)
+}
+
+func TestRateLimitTokens(t *testing.T) {
+	clock := &fakeClock{}
+
+	r := scanner.NewRateLimit(topic{}, 1000, 1000)
+	r.Tokens(3, 3)
+	r.Clock(clock)
+
+	for range 4 {
+		r.Embedding(context.Background(), "bb.")
+	}
+
+	it.Then(t).Should(
+		it.Seq(clock.sleeps).Equal(time.Second, time.Second),
+	)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	clock := &fakeClock{}
+	api := &flaky{fails: 3, err: errors.New("down")}
+
+	cb := scanner.NewCircuitBreaker(api, 2, time.Minute)
+	cb.Clock(clock)
+
+	_, _, err1 := cb.Embedding(context.Background(), "bb.")
+	_, _, err2 := cb.Embedding(context.Background(), "bb.")
+	_, _, err3 := cb.Embedding(context.Background(), "bb.")
+
+	it.Then(t).Should(
+		it.Fail(func() error { return err1 }).Contain("down"),
+		it.Fail(func() error { return err2 }).Contain("down"),
+		it.Equiv(err3, scanner.ErrCircuitOpen),
+		it.Equal(api.calls, 2),
+	)
+
+	// failed probe opens the circuit again
+	clock.now = clock.now.Add(time.Minute)
+	_, _, err4 := cb.Embedding(context.Background(), "bb.")
+	_, _, err5 := cb.Embedding(context.Background(), "bb.")
+
+	it.Then(t).Should(
+		it.Fail(func() error { return err4 }).Contain("down"),
+		it.Equiv(err5, scanner.ErrCircuitOpen),
+		it.Equal(api.calls, 3),
+	)
+
+	// successful probe closes the circuit
+	clock.now = clock.now.Add(time.Minute)
+	_, _, err6 := cb.Embedding(context.Background(), "bb.")
+	_, _, err7 := cb.Embedding(context.Background(), "bb.")
+
+	it.Then(t).Should(
+		it.Nil(err6),
+		it.Nil(err7),
+		it.Equal(api.calls, 5),
+	)
+}
+
+func TestMiddlewareEmbeddings(t *testing.T) {
+	text := []string{"a.", "bb.", "c."}
+
+	t.Run("Retry", func(t *testing.T) {
+		api := &flakyBatch{flaky{fails: 1, err: scanner.Transient(errors.New("throttled"))}}
+
+		r := scanner.NewRetry(api)
+		r.Clock(&fakeClock{})
+
+		seq, n, err := r.Embeddings(context.Background(), text)
+
+		it.Then(t).Should(
+			it.Nil(err),
+			it.Equal(len(seq), 3),
+			it.Seq(seq[1]).Equal(0, 1, 0, 0),
+			it.Equal(n, 7),
+			it.Equal(api.calls, 2),
+		)
+	})
+
+	t.Run("RateLimit", func(t *testing.T) {
+		clock := &fakeClock{}
+		api := &flakyBatch{}
+
+		r := scanner.NewRateLimit(api, 1, 2)
+		r.Clock(clock)
+
+		for range 4 {
+			r.Embeddings(context.Background(), text)
+		}
+
+		it.Then(t).Should(
+			it.Seq(clock.sleeps).Equal(time.Second, time.Second),
+			it.Equal(api.calls, 4),
+		)
+	})
+
+	t.Run("CircuitBreaker", func(t *testing.T) {
+		api := &flakyBatch{flaky{fails: 3, err: errors.New("down")}}
+
+		cb := scanner.NewCircuitBreaker(api, 2, time.Minute)
+		cb.Clock(&fakeClock{})
+
+		_, _, err1 := cb.Embeddings(context.Background(), text)
+		_, _, err2 := cb.Embeddings(context.Background(), text)
+		_, _, err3 := cb.Embeddings(context.Background(), text)
+
+		it.Then(t).Should(
+			it.Fail(func() error { return err1 }).Contain("down"),
+			it.Fail(func() error { return err2 }).Contain("down"),
+			it.Equiv(err3, scanner.ErrCircuitOpen),
+			it.Equal(api.calls, 2),
+		)
+	})
+
+	t.Run("Sequential", func(t *testing.T) {
+		api := &flaky{}
+
+		seq, n, err := scanner.NewRetry(api).Embeddings(context.Background(), text)
+
+		it.Then(t).Should(
+			it.Nil(err),
+			it.Equal(len(seq), 3),
+			it.Equal(n, 7),
+			it.Equal(api.calls, 3),
+		)
+	})
+}
+
+func TestScannerMiddlewareBatch(t *testing.T) {
+	text := "a. bb. c. ddd. ff."
+
+	api := &batch{}
+	s := scanner.NewSemantic(
+		scanner.NewRetry(scanner.NewCircuitBreaker(api, 5, time.Minute)),
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Similarity(similar)
+	s.Window(5)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a.", "c."),
+		it.Equal(api.calls, 1),
+	)
+}
+
+//------------------------------------------------------------------------------
+
+// fakeClock advances the time instantly, recording every delay
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+// flaky fails first n calls
+type flaky struct {
+	fails int
+	calls int
+	err   error
+}
+
+func (f *flaky) Embedding(ctx context.Context, text string) ([]float32, int, error) {
+	f.calls++
+	if f.calls <= f.fails {
+		return nil, 0, f.err
+	}
+	return topic{}.Embedding(ctx, text)
+}
+
+// flakyBatch fails first n batch calls
+type flakyBatch struct{ flaky }
+
+func (f *flakyBatch) Embeddings(ctx context.Context, text []string) ([][]float32, int, error) {
+	f.calls++
+	if f.calls <= f.fails {
+		return nil, 0, f.err
+	}
+	return scanner.NewBatch(topic{}, 1).Embeddings(ctx, text)
+}
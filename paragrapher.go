@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"bytes"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// [bufio.SplitFunc] for paragraph, paragraphs are separated by one or more
+// blank lines. Lines made of whitespaces are blank, both "\n" and "\r\n"
+// line breaks are supported. Leading and trailing whitespaces of
+// the paragraph are skipped.
+func Paragrapher(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	// Skip leading spaces.
+	start := 0
+	for width := 0; start < len(data); start += width {
+		var r rune
+		r, width = utf8.DecodeRune(data[start:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+	}
+
+	if atEOF && start == len(data) {
+		return len(data), nil, nil
+	}
+
+	// Scan until blank line \n[ \t\r]*\n
+	for i := start; i < len(data); i++ {
+		if data[i] != '\n' {
+			continue
+		}
+
+		blank, more := blankLine(data[i+1:], atEOF)
+		if more {
+			// Request more data to look ahead of the line break.
+			return 0, nil, nil
+		}
+
+		if blank {
+			end := start + len(bytes.TrimRightFunc(data[start:i], unicode.IsSpace))
+			return i, data[start:end], nil
+		}
+	}
+
+	// If we're at EOF, we have a final paragraph. Return it.
+	if atEOF {
+		end := start + len(bytes.TrimRightFunc(data[start:], unicode.IsSpace))
+		return len(data), data[start:end], nil
+	}
+
+	// Request more data.
+	return 0, nil, nil
+}
+
+// checks if the line is blank, the end of input is the blank line
+func blankLine(data []byte, atEOF bool) (blank bool, more bool) {
+	for i := 0; i < len(data); {
+		r, width := utf8.DecodeRune(data[i:])
+		switch {
+		case r == '\n':
+			return true, false
+		case !unicode.IsSpace(r):
+			return false, false
+		}
+		i += width
+	}
+
+	return atEOF, !atEOF
+}
+
+// Create a scanner that slices input stream by paragraphs
+func NewParagrapher(r io.Reader) *Splitter {
+	return NewSplitter(Paragrapher, r)
+}
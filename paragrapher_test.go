@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestParagrapher(t *testing.T) {
+	for input, expected := range map[string][]string{
+		"Hello World!":                     {"Hello World!"},
+		"Hello!\nWorld.":                   {"Hello!\nWorld."},
+		"Hello!\n\nWorld.":                 {"Hello!", "World."},
+		"\n\nHello!  \n \t \n\n\nWorld.\n": {"Hello!", "World."},
+		"Hello!\r\n\r\nWorld.\r\n":         {"Hello!", "World."},
+		" \n\n ":                           {},
+	} {
+		s := scanner.NewParagrapher(strings.NewReader(input))
+		s.Buffer(make([]byte, 4), 1024)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(expected...),
+		)
+	}
+}
+
+func TestParagrapherChunk(t *testing.T) {
+	text := "# Title\n\nFirst. Second.\n\n\nThird.\nFourth."
+
+	split := scanner.NewSentenceSplit(scanner.EndOfSentence)
+	split.Paragraphs()
+
+	s := scanner.NewSplitter(split.Split, strings.NewReader(text))
+
+	seq := make([]string, 0)
+	para := make([]int, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+		para = append(para, s.Chunk().Start.Paragraph)
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("# Title", "First.", "Second.", "Third.", "Fourth."),
+		it.Seq(para).Equal(0, 1, 1, 2, 2),
+	)
+
+	c := scanner.NewChunker(100, scanner.NewParagrapher(strings.NewReader(text)))
+	c.Joiner("\n\n")
+
+	it.Then(t).Should(
+		it.True(c.Scan()),
+		it.Equal(c.Chunk().Start.Paragraph, 0),
+		it.Equal(c.Chunk().End.Paragraph, 2),
+	)
+}
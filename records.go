@@ -0,0 +1,218 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fogfish/golem/optics"
+	"github.com/fogfish/golem/trait/seq"
+)
+
+// ErrQuote is returned if the quoted field is not terminated.
+var ErrQuote = errors.New("unterminated quoted field")
+
+// CSV defines rules of parsing CSV-like records (RFC 4180). Fields are
+// separated by delimiter, records by line breaks ("\n" or "\r\n"). Fields
+// are optionally enclosed into double quotes, quoted fields contain
+// delimiters and line breaks, the double quote is escaped by preceding one.
+// Empty lines are skipped.
+type CSV struct {
+	confDelimiter rune
+	confEscape    rune
+}
+
+// Creates rules for comma separated records.
+func NewCSVSplit() *CSV {
+	return &CSV{confDelimiter: ','}
+}
+
+// Delimiter sets the field delimiter (e.g. ',', ';' or '\t').
+// The default value is comma.
+func (s *CSV) Delimiter(r rune) {
+	s.confDelimiter = r
+}
+
+// Escape sets the escape character (e.g. '\\'), the rune following it is
+// taken literally. The default value is 0, escaping is disabled.
+func (s *CSV) Escape(r rune) {
+	s.confEscape = r
+}
+
+// [bufio.SplitFunc] for records, the token is the record as-is.
+func (s *CSV) Split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	_, end, advance, more, err := s.parse(data, atEOF)
+	if err != nil || more {
+		return 0, nil, err
+	}
+
+	// Skip empty lines.
+	if end == 0 {
+		return advance, nil, nil
+	}
+
+	return advance, data[:end], nil
+}
+
+// parse the record, it returns fields, end of the record and length of
+// the record including line break.
+func (s *CSV) parse(data []byte, atEOF bool) (fields []string, end, advance int, more bool, err error) {
+	var (
+		field  strings.Builder
+		start  = true
+		quoted = false
+	)
+
+	// rune at the offset, it requests more data if the rune is incomplete
+	at := func(i int) (rune, int, bool) {
+		if i >= len(data) || (!atEOF && !utf8.FullRune(data[i:])) {
+			return 0, 0, !atEOF
+		}
+		r, n := utf8.DecodeRune(data[i:])
+		return r, n, false
+	}
+
+	for i := 0; i < len(data); {
+		r, n, more := at(i)
+		if more {
+			return nil, 0, 0, true, nil
+		}
+
+		switch {
+		case r == s.confEscape && s.confEscape != 0:
+			_, m, more := at(i + n)
+			if more {
+				return nil, 0, 0, true, nil
+			}
+			field.Write(data[i+n : i+n+m])
+			i += n + m
+			start = false
+			continue
+		case quoted && r == '"':
+			if x, m, more := at(i + n); more {
+				return nil, 0, 0, true, nil
+			} else if x == '"' && m > 0 {
+				field.WriteByte('"')
+				i += n + m
+				continue
+			}
+			quoted = false
+		case quoted:
+			field.Write(data[i : i+n])
+		case start && r == '"':
+			quoted = true
+		case r == s.confDelimiter:
+			fields = append(fields, field.String())
+			field.Reset()
+			start = true
+			i += n
+			continue
+		case r == '\n' || (r == '\r' && i+n < len(data) && data[i+n] == '\n'):
+			fields = append(fields, field.String())
+			if r == '\r' {
+				return fields, i, i + n + 1, false, nil
+			}
+			return fields, i, i + n, false, nil
+		case r == '\r' && !atEOF && i+n == len(data):
+			return nil, 0, 0, true, nil
+		default:
+			field.Write(data[i : i+n])
+		}
+
+		start = false
+		i += n
+	}
+
+	if !atEOF {
+		return nil, 0, 0, true, nil
+	}
+
+	if quoted {
+		return nil, 0, 0, false, ErrQuote
+	}
+
+	fields = append(fields, field.String())
+	return fields, len(data), len(data), false, nil
+}
+
+// Record is the sequence of fields
+type Record []string
+
+// Records is the scanner of CSV-like records. Use [Records.Record] to get
+// fields of the current record, the [Splitter.Text] is the record as-is.
+type Records struct {
+	*Splitter
+	csv *CSV
+}
+
+// Create a scanner of records using parsing rules (see [NewCSVSplit]).
+func NewRecords(csv *CSV, r io.Reader) *Records {
+	return &Records{
+		Splitter: NewSplitter(csv.Split, r),
+		csv:      csv,
+	}
+}
+
+// Record returns fields of the most recent record generated by a call to Scan.
+func (s *Records) Record() Record {
+	text := s.Text()
+	if len(text) == 0 {
+		return nil
+	}
+
+	fields, _, _, _, err := s.csv.parse([]byte(text), true)
+	if err != nil {
+		return nil
+	}
+	return Record(fields)
+}
+
+// Seq returns remaining records as the sequence (e.g. source of [NewSorter]),
+// it is nil if there are no records. Use [Records.Err] to check errors
+// once the sequence is over.
+func (s *Records) Seq() seq.Seq[Record] {
+	if !s.Scan() {
+		return nil
+	}
+
+	return recordSeq{s}
+}
+
+type recordSeq struct{ *Records }
+
+func (s recordSeq) Value() Record { return s.Record() }
+func (s recordSeq) Next() bool    { return s.Scan() }
+
+// Column is the lens focusing on the field of the record, missing fields
+// are empty.
+func Column(i int) optics.Lens[Record, string] { return column(i) }
+
+type column int
+
+func (c column) Get(r *Record) string {
+	if int(c) < len(*r) {
+		return (*r)[c]
+	}
+	return ""
+}
+
+func (c column) Put(r *Record, v string) *Record {
+	for len(*r) <= int(c) {
+		*r = append(*r, "")
+	}
+	(*r)[c] = v
+	return r
+}
@@ -0,0 +1,102 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestRecords(t *testing.T) {
+	text := "id,name,note\r\n" +
+		"1,\"Smith, John\",\"said \"\"hi\"\"\"\r\n" +
+		"\n" +
+		"2,Doe,\"multi\nline\"\n" +
+		"3,,"
+
+	s := scanner.NewRecords(scanner.NewCSVSplit(), strings.NewReader(text))
+	s.Buffer(make([]byte, 2), 1024)
+
+	seq := make([]scanner.Record, 0)
+	raw := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Record())
+		raw = append(raw, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Nil(s.Err()),
+		it.Equal(len(seq), 4),
+		it.Seq(seq[0]).Equal("id", "name", "note"),
+		it.Seq(seq[1]).Equal("1", "Smith, John", `said "hi"`),
+		it.Seq(seq[2]).Equal("2", "Doe", "multi\nline"),
+		it.Seq(seq[3]).Equal("3", "", ""),
+		it.Equal(raw[2], "2,Doe,\"multi\nline\""),
+	)
+}
+
+func TestRecordsShared(t *testing.T) {
+	csv := scanner.NewCSVSplit()
+	a := scanner.NewRecords(csv, strings.NewReader("a1,a2\n"))
+	b := scanner.NewRecords(csv, strings.NewReader("b1,b2\n"))
+
+	it.Then(t).Should(
+		it.True(a.Scan()),
+		it.True(b.Scan()),
+		it.Seq(a.Record()).Equal("a1", "a2"),
+		it.Seq(b.Record()).Equal("b1", "b2"),
+	)
+}
+
+func TestRecordsConfig(t *testing.T) {
+	text := "a\\\tb\t\"c\\\"d\"\te\\\\\n"
+
+	csv := scanner.NewCSVSplit()
+	csv.Delimiter('\t')
+	csv.Escape('\\')
+
+	s := scanner.NewRecords(csv, strings.NewReader(text))
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Record()).Equal("a\tb", `c"d`, `e\`),
+	).ShouldNot(
+		it.True(s.Scan()),
+	)
+}
+
+func TestRecordsUnterminated(t *testing.T) {
+	s := scanner.NewRecords(scanner.NewCSVSplit(), strings.NewReader("1,\"abc\n2,def"))
+
+	it.Then(t).ShouldNot(
+		it.True(s.Scan()),
+	).Should(
+		it.True(errors.Is(s.Err(), scanner.ErrQuote)),
+	)
+}
+
+func TestRecordsSorter(t *testing.T) {
+	text := "1,a1\n2,b2\n3,a3\n"
+
+	s := scanner.NewRecords(scanner.NewCSVSplit(), strings.NewReader(text))
+	sorter := scanner.NewSorter(topic{}, scanner.Column(1), s.Seq())
+
+	it.Then(t).Should(
+		it.True(sorter.Next()),
+		it.Equal(len(sorter.Value()), 2),
+		it.Seq(sorter.Value()[0]).Equal("1", "a1"),
+		it.Seq(sorter.Value()[1]).Equal("3", "a3"),
+		it.True(sorter.Next()),
+		it.Seq(sorter.Value()[0]).Equal("2", "b2"),
+	)
+}
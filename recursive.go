@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Default separators of recursive splitter: paragraphs, lines, sentences
+// and words.
+var RecursiveSplits = []bufio.SplitFunc{
+	Slicer("\n\n").Split,
+	Slicer("\n").Split,
+	NewSentenceSplit(EndOfSentence).Split,
+	bufio.ScanWords,
+}
+
+// Recursive is the recursive character text splitter. It splits the input
+// using the first split function, pieces above the size limit are split
+// using the following split functions, recursing into finer separators.
+// Pieces still above the limit after the last split function are split at
+// rune boundaries. Pieces of the first split function above the max token
+// size of [Splitter] are cut at the last whitespace (see OVERSIZE_SPLIT).
+// Small neighbour pieces are merged back up to the limit, the original
+// separators are preserved, so that the chunk is an exact substring of
+// the input. The size is measured in bytes.
+type Recursive struct {
+	top    *Splitter
+	size   int
+	splits []bufio.SplitFunc
+	queue  []unit
+	lead   string
+	seq    int
+	units  []Chunk
+	sbuf   strings.Builder
+}
+
+// Creates recursive splitter, the ordered list of split functions defines
+// separators from the coarsest to the finest one (e.g. [Slicer.Split] or
+// [SentenceSplit.Split]). The [RecursiveSplits] are used if none is given.
+func NewRecursive(size int, r io.Reader, splits ...bufio.SplitFunc) *Recursive {
+	if len(splits) == 0 {
+		splits = RecursiveSplits
+	}
+
+	// oversize pieces are cut at whitespace, then recursed into
+	top := NewSplitter(splits[0], r)
+	top.Oversize(OVERSIZE_SPLIT)
+
+	return &Recursive{
+		top:    top,
+		size:   size,
+		splits: splits,
+	}
+}
+
+func (s *Recursive) Scan() bool {
+	s.units = s.units[:0]
+	s.sbuf.Reset()
+
+	for {
+		if len(s.queue) == 0 {
+			if !s.top.Scan() {
+				break
+			}
+
+			u := unit{Chunk: s.top.Chunk(), sep: s.lead + s.top.Separator()}
+			if len(u.Text) == 0 {
+				s.lead = u.sep
+				continue
+			}
+
+			s.lead = ""
+			s.queue = s.pieces(u, 1)
+		}
+
+		u := s.queue[0]
+		n := len(u.Text)
+		if len(s.units) > 0 {
+			n += len(u.sep)
+		}
+
+		if len(s.units) > 0 && s.sbuf.Len()+n > s.size {
+			break
+		}
+
+		if len(s.units) > 0 {
+			s.sbuf.WriteString(u.sep)
+		}
+		s.sbuf.WriteString(u.Text)
+
+		u.Sentences = []int{s.seq}
+		s.seq++
+		s.units = append(s.units, u.Chunk)
+		s.queue = s.queue[1:]
+	}
+
+	return len(s.units) > 0
+}
+
+func (s *Recursive) Err() error   { return s.top.Err() }
+func (s *Recursive) Text() string { return s.sbuf.String() }
+
+// Chunk returns the most recent chunk annotated with position of
+// the pieces it is made of.
+func (s *Recursive) Chunk() Chunk { return joinChunks(s.Text(), s.units) }
+
+// split the unit into pieces fitting the size using split function at level
+func (s *Recursive) pieces(u unit, level int) []unit {
+	if len(u.Text) <= s.size {
+		return []unit{u}
+	}
+
+	if level >= len(s.splits) {
+		return s.runes(u)
+	}
+
+	seq := make([]unit, 0)
+	lead := u.sep
+	pos, at := u.Start, 0
+	sub := NewSplitter(s.splits[level], strings.NewReader(u.Text))
+	sub.Buffer(nil, max(bufio.MaxScanTokenSize, len(u.Text)+1))
+	for sub.Scan() {
+		c := sub.Chunk()
+		start := forward(pos, []byte(u.Text[at:c.Start.Offset]))
+		end := forward(start, []byte(u.Text[c.Start.Offset:c.End.Offset]))
+		pos, at = end, c.End.Offset
+
+		x := unit{
+			Chunk: Chunk{
+				Span: Span{Start: start, End: end},
+				Text: c.Text,
+			},
+			sep: lead + sub.Separator(),
+		}
+		x.Start.Paragraph = u.Start.Paragraph + c.Start.Paragraph
+		x.End.Paragraph = u.Start.Paragraph + c.End.Paragraph
+		x.Spans = []Span{x.Span}
+
+		if len(x.Text) == 0 {
+			lead = x.sep
+			continue
+		}
+
+		lead = ""
+		seq = append(seq, x)
+	}
+
+	// split function makes no progress, use the finer one
+	if len(seq) == 1 && seq[0].Text == u.Text {
+		return s.pieces(u, level+1)
+	}
+
+	pieces := make([]unit, 0, len(seq))
+	for _, x := range seq {
+		pieces = append(pieces, s.pieces(x, level+1)...)
+	}
+
+	return pieces
+}
+
+// split the unit at rune boundaries
+func (s *Recursive) runes(u unit) []unit {
+	pieces := make([]unit, 0)
+	text, pos, sep := u.Text, u.Start, u.sep
+
+	for len(text) > 0 {
+		n := min(max(s.size, 1), len(text))
+		for n < len(text) && n > 0 && !utf8.RuneStart(text[n]) {
+			n--
+		}
+		if n == 0 {
+			_, n = utf8.DecodeRuneInString(text)
+		}
+
+		end := forward(pos, []byte(text[:n]))
+		span := Span{Start: pos, End: end}
+		pieces = append(pieces, unit{
+			Chunk: Chunk{Span: span, Text: text[:n], Spans: []Span{span}},
+			sep:   sep,
+		})
+		text, pos, sep = text[n:], end, ""
+	}
+
+	return pieces
+}
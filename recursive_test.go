@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestRecursive(t *testing.T) {
+	text := "Hello World!\n\nFirst line. Second sentence here.\nThird line\n\nSupercalifragilistic"
+
+	s := scanner.NewRecursive(20, strings.NewReader(text))
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		it.Then(t).ShouldNot(
+			it.Greater(len(s.Text()), 20),
+		)
+
+		c := s.Chunk()
+		it.Then(t).Should(
+			it.Equal(text[c.Start.Offset:c.End.Offset], s.Text()),
+		)
+		seq = append(seq, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Nil(s.Err()),
+		it.Seq(seq).Equal(
+			"Hello World!",
+			"First line. Second",
+			"sentence here.",
+			"Third line",
+			"Supercalifragilistic",
+		),
+	)
+}
+
+func TestRecursiveRunes(t *testing.T) {
+	s := scanner.NewRecursive(5, strings.NewReader("Приветмир"))
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("Пр", "ив", "ет", "ми", "р"),
+	)
+}
+
+func TestRecursiveLarge(t *testing.T) {
+	text := strings.Repeat("word ", 20000)
+	s := scanner.NewRecursive(1000, strings.NewReader(text))
+
+	words := 0
+	for s.Scan() {
+		it.Then(t).ShouldNot(
+			it.Greater(len(s.Text()), 1000),
+		)
+
+		c := s.Chunk()
+		it.Then(t).Should(
+			it.Equal(text[c.Start.Offset:c.End.Offset], s.Text()),
+		)
+		words += len(strings.Fields(s.Text()))
+	}
+
+	it.Then(t).Should(
+		it.Nil(s.Err()),
+		it.Equal(words, 20000),
+	)
+}
+
+func TestRecursiveSplits(t *testing.T) {
+	s := scanner.NewRecursive(7, strings.NewReader("a,b,c;dd,ee;f"),
+		scanner.Slicer(";").Split,
+		scanner.Slicer(",").Split,
+	)
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("a,b,c", "dd,ee;f"),
+	)
+}
@@ -0,0 +1,321 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"unicode/utf8"
+)
+
+// [bufio.SplitFunc] for extended grapheme clusters, user-perceived
+// characters as defined by Unicode Text Segmentation (UAX #29).
+func SegmentGraphemes(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	c := cursor{data: data, atEOF: atEOF, prop: graphemeProp}
+	a, i, more := c.at(0)
+	if more || i == 0 {
+		return 0, nil, nil
+	}
+
+	ri := 0
+	if a == propRI {
+		ri = 1
+	}
+	pict, zwj := a == propExtPict, false
+	conjunct := conjunctOf(0, a)
+
+	for {
+		x, w, more := c.at(i)
+		switch {
+		case more:
+			return 0, nil, nil
+		case w == 0:
+			return i, data[:i], nil
+		}
+
+		b := x &^ propInCB
+		linked := conjunct == 2 && x&propConsonant != 0
+		if graphemeBreak(a&^propInCB, b, ri, zwj, linked) {
+			return i, data[:i], nil
+		}
+
+		if b == propRI {
+			ri++
+		} else {
+			ri = 0
+		}
+		zwj = b == propZWJ && pict
+		pict = b == propExtPict || (pict && b == propExtend)
+		conjunct = conjunctOf(conjunct, x)
+
+		a, i = x, i+w
+	}
+}
+
+// state of Indic conjunct cluster after the rune:
+// 1 - Consonant [Extend Linker]*, 2 - Consonant [Extend Linker]* Linker [Extend Linker]*
+func conjunctOf(state int, x prop) int {
+	switch {
+	case x&propConsonant != 0:
+		return 1
+	case x&propLinker != 0 && state > 0:
+		return 2
+	case x&propConjunct != 0 && state > 0:
+		return state
+	}
+
+	return 0
+}
+
+func graphemeBreak(a, b prop, ri int, zwj, linked bool) bool {
+	switch {
+	case a == propCR && b == propLF: // GB3
+		return false
+	case a&(propControl|propCR|propLF) != 0: // GB4
+		return true
+	case b&(propControl|propCR|propLF) != 0: // GB5
+		return true
+	case a == propL && b&(propL|propV|propLV|propLVT) != 0: // GB6
+		return false
+	case a&(propLV|propV) != 0 && b&(propV|propT) != 0: // GB7
+		return false
+	case a&(propLVT|propT) != 0 && b == propT: // GB8
+		return false
+	case b&(propExtend|propZWJ|propSpacingMark) != 0: // GB9, GB9a
+		return false
+	case a == propPrepend: // GB9b
+		return false
+	case linked: // GB9c
+		return false
+	case a == propZWJ && zwj && b == propExtPict: // GB11
+		return false
+	case a == propRI && b == propRI && ri%2 == 1: // GB12, GB13
+		return false
+	}
+
+	return true
+}
+
+// [bufio.SplitFunc] for words as defined by Unicode Text Segmentation
+// (UAX #29). Unlike [bufio.ScanWords], every segment of input is returned,
+// including whitespaces and punctuation.
+func SegmentWords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	c := cursor{data: data, atEOF: atEOF, prop: wordProp}
+	a, i, more := c.at(0)
+	if more || i == 0 {
+		return 0, nil, nil
+	}
+
+	// the last and the one before the last properties ignoring Extend and Format
+	p, pp := a, propOther
+	ri := 0
+	if a == propRI {
+		ri = 1
+	}
+
+	const (
+		ignore     = propExtend | propFormat | propZWJ
+		newline    = propNewline | propCR | propLF
+		ahletter   = propALetter | propHebrew
+		midnumletq = propMidNumLet | propSingleQuote
+	)
+
+	for {
+		b, w, more := c.at(i)
+		switch {
+		case more:
+			return 0, nil, nil
+		case w == 0:
+			return i, data[:i], nil
+		}
+
+		// the next property after b ignoring Extend and Format
+		more = false
+		next := func() prop {
+			n, m := c.skip(i+w, func(p prop) bool { return p&ignore != 0 })
+			more = more || m
+			return n
+		}
+
+		brk := true
+		switch {
+		case a == propCR && b == propLF: // WB3
+			brk = false
+		case a&newline != 0 || b&newline != 0: // WB3a, WB3b
+			brk = true
+		case a == propZWJ && b&propExtPict != 0: // WB3c
+			brk = false
+		case a == propWSegSpace && b == propWSegSpace: // WB3d
+			brk = false
+		case b&ignore != 0: // WB4
+			a, i = b, i+w
+			continue
+		case p&ahletter != 0 && b&ahletter != 0: // WB5
+			brk = false
+		case p&ahletter != 0 && b&(propMidLetter|midnumletq) != 0 && next()&ahletter != 0: // WB6
+			brk = false
+		case pp&ahletter != 0 && p&(propMidLetter|midnumletq) != 0 && b&ahletter != 0: // WB7
+			brk = false
+		case p == propHebrew && b == propSingleQuote: // WB7a
+			brk = false
+		case p == propHebrew && b == propDoubleQuote && next() == propHebrew: // WB7b
+			brk = false
+		case pp == propHebrew && p == propDoubleQuote && b == propHebrew: // WB7c
+			brk = false
+		case p&(propNumeric|ahletter) != 0 && b&(propNumeric|ahletter) != 0: // WB8, WB9, WB10
+			brk = false
+		case pp == propNumeric && p&(propMidNum|midnumletq) != 0 && b == propNumeric: // WB11
+			brk = false
+		case p == propNumeric && b&(propMidNum|midnumletq) != 0 && next() == propNumeric: // WB12
+			brk = false
+		case p == propKatakana && b == propKatakana: // WB13
+			brk = false
+		case p&(ahletter|propNumeric|propKatakana|propExtendNumLet) != 0 && b == propExtendNumLet: // WB13a
+			brk = false
+		case p == propExtendNumLet && b&(ahletter|propNumeric|propKatakana) != 0: // WB13b
+			brk = false
+		case p == propRI && b == propRI && ri%2 == 1: // WB15, WB16
+			brk = false
+		}
+
+		if more {
+			// Request more data to look ahead of the boundary.
+			return 0, nil, nil
+		}
+
+		if brk {
+			return i, data[:i], nil
+		}
+
+		if b == propRI {
+			ri++
+		} else {
+			ri = 0
+		}
+
+		a, p, pp, i = b, b, p, i+w
+	}
+}
+
+// [bufio.SplitFunc] for sentences as defined by Unicode Text Segmentation
+// (UAX #29). The sentence includes trailing whitespaces.
+func SegmentSentences(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	c := cursor{data: data, atEOF: atEOF, prop: sentenceProp}
+	a, i, more := c.at(0)
+	if more || i == 0 {
+		return 0, nil, nil
+	}
+
+	const (
+		parasep = propSep | propCR | propLF
+		saterm  = propATerm | propSTerm
+		lookup  = propOLetter | propUpper | propLower | parasep | saterm
+	)
+
+	// the last property ignoring Extend and Format, the terminator
+	// of sentence, the property before it and position after it:
+	// 1 - SATerm, 2 - SATerm Close+, 3 - SATerm Close* Sp+
+	p, term, before, phase := a, propOther, propOther, 0
+	if a&saterm != 0 {
+		term, phase = a, 1
+	}
+
+	for {
+		b, w, more := c.at(i)
+		switch {
+		case more:
+			return 0, nil, nil
+		case w == 0:
+			return i, data[:i], nil
+		}
+
+		brk := false
+		switch {
+		case a == propCR && b == propLF: // SB3
+			brk = false
+		case a&parasep != 0: // SB4
+			brk = true
+		case b&(propExtend|propFormat) != 0: // SB5
+			a, i = b, i+w
+			continue
+		case term == propOther: // SB998
+			brk = false
+		case term == propATerm && phase == 1 && b == propNumeric: // SB6
+			brk = false
+		case term == propATerm && phase == 1 && before&(propUpper|propLower) != 0 && b == propUpper: // SB7
+			brk = false
+		case b&(propSContinue|saterm) != 0: // SB8a
+			brk = false
+		case phase <= 2 && b&(propClose|propSp|parasep) != 0: // SB9
+			brk = false
+		case b&(propSp|parasep) != 0: // SB10
+			brk = false
+		case term == propATerm: // SB8, SB11
+			n, more := c.skip(i, func(p prop) bool { return p&lookup == 0 })
+			if more {
+				return 0, nil, nil
+			}
+			brk = n != propLower
+		default: // SB11
+			brk = true
+		}
+
+		if brk {
+			return i, data[:i], nil
+		}
+
+		switch {
+		case b&saterm != 0:
+			term, before, phase = b, p, 1
+		case term != propOther && b == propClose && phase <= 2:
+			phase = 2
+		case term != propOther && b == propSp:
+			phase = 3
+		case term != propOther && b&parasep != 0:
+			// SB4 breaks after the paragraph separator
+		default:
+			term, phase = propOther, 0
+		}
+
+		a, p, i = b, b, i+w
+	}
+}
+
+// cursor over runes of the buffer, it signals a lack of data to decide
+// on the boundary unless the input is over.
+type cursor struct {
+	data  []byte
+	atEOF bool
+	prop  func(rune) prop
+}
+
+// property and width of the rune at offset, the width is 0 at the end of input.
+func (c cursor) at(i int) (p prop, width int, more bool) {
+	if i >= len(c.data) || (!c.atEOF && !utf8.FullRune(c.data[i:])) {
+		return propOther, 0, !c.atEOF
+	}
+
+	r, width := utf8.DecodeRune(c.data[i:])
+	return c.prop(r), width, false
+}
+
+// property of the first rune at or after offset that is not skipped,
+// it is propOther at the end of input.
+func (c cursor) skip(i int, skip func(prop) bool) (prop, bool) {
+	for {
+		p, w, more := c.at(i)
+		if more || w == 0 {
+			return propOther, more
+		}
+
+		if !skip(p) {
+			return p, false
+		}
+
+		i += w
+	}
+}
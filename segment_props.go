@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import "sort"
+
+//go:generate go run gen_segment.go
+
+// Break properties of Unicode Text Segmentation (UAX #29). Tables of
+// properties are generated from files of Unicode Character Database,
+// see ucd/README.md.
+
+// property of the rune, bit flags allow set membership tests
+type prop uint64
+
+const (
+	propOther prop = 0
+	propCR    prop = 1 << iota
+	propLF
+	propControl
+	propExtend
+	propZWJ
+	propRI
+	propPrepend
+	propSpacingMark
+	propL
+	propV
+	propT
+	propLV
+	propLVT
+	propExtPict
+	propConsonant
+	propLinker
+	propConjunct
+	propNewline
+	propALetter
+	propHebrew
+	propKatakana
+	propNumeric
+	propExtendNumLet
+	propFormat
+	propWSegSpace
+	propSingleQuote
+	propDoubleQuote
+	propMidNumLet
+	propMidLetter
+	propMidNum
+	propSep
+	propSp
+	propLower
+	propUpper
+	propOLetter
+	propATerm
+	propSTerm
+	propClose
+	propSContinue
+)
+
+// Indic_Conjunct_Break property values, they are combined with
+// Grapheme_Cluster_Break value of the rune.
+const propInCB = propConsonant | propLinker | propConjunct
+
+// range of runes sharing properties
+type propRange struct {
+	lo, hi rune
+	prop   prop
+}
+
+// properties of the rune, the table is sorted by ranges
+func lookup(table []propRange, r rune) prop {
+	i := sort.Search(len(table), func(i int) bool { return table[i].hi >= r })
+	if i < len(table) && table[i].lo <= r {
+		return table[i].prop
+	}
+
+	return propOther
+}
+
+// Grapheme_Cluster_Break, Indic_Conjunct_Break and Extended_Pictographic
+// properties
+func graphemeProp(r rune) prop { return lookup(graphemeTable, r) }
+
+// Word_Break and Extended_Pictographic properties
+func wordProp(r rune) prop { return lookup(wordTable, r) }
+
+// Sentence_Break property
+func sentenceProp(r rune) prop { return lookup(sentenceTable, r) }
@@ -0,0 +1,5399 @@
+// Code generated by gen_segment.go from Unicode Character Database 17.0.0. DO NOT EDIT.
+
+package scanner
+
+// Grapheme_Cluster_Break, Indic_Conjunct_Break and Extended_Pictographic properties
+var graphemeTable = []propRange{
+	{0x0000, 0x0009, propControl},
+	{0x000A, 0x000A, propLF},
+	{0x000B, 0x000C, propControl},
+	{0x000D, 0x000D, propCR},
+	{0x000E, 0x001F, propControl},
+	{0x007F, 0x009F, propControl},
+	{0x00A9, 0x00A9, propExtPict},
+	{0x00AD, 0x00AD, propControl},
+	{0x00AE, 0x00AE, propExtPict},
+	{0x0300, 0x036F, propExtend | propConjunct},
+	{0x0483, 0x0489, propExtend | propConjunct},
+	{0x0591, 0x05BD, propExtend | propConjunct},
+	{0x05BF, 0x05BF, propExtend | propConjunct},
+	{0x05C1, 0x05C2, propExtend | propConjunct},
+	{0x05C4, 0x05C5, propExtend | propConjunct},
+	{0x05C7, 0x05C7, propExtend | propConjunct},
+	{0x0600, 0x0605, propPrepend},
+	{0x0610, 0x061A, propExtend | propConjunct},
+	{0x061C, 0x061C, propControl},
+	{0x064B, 0x065F, propExtend | propConjunct},
+	{0x0670, 0x0670, propExtend | propConjunct},
+	{0x06D6, 0x06DC, propExtend | propConjunct},
+	{0x06DD, 0x06DD, propPrepend},
+	{0x06DF, 0x06E4, propExtend | propConjunct},
+	{0x06E7, 0x06E8, propExtend | propConjunct},
+	{0x06EA, 0x06ED, propExtend | propConjunct},
+	{0x070F, 0x070F, propPrepend},
+	{0x0711, 0x0711, propExtend | propConjunct},
+	{0x0730, 0x074A, propExtend | propConjunct},
+	{0x07A6, 0x07B0, propExtend | propConjunct},
+	{0x07EB, 0x07F3, propExtend | propConjunct},
+	{0x07FD, 0x07FD, propExtend | propConjunct},
+	{0x0816, 0x0819, propExtend | propConjunct},
+	{0x081B, 0x0823, propExtend | propConjunct},
+	{0x0825, 0x0827, propExtend | propConjunct},
+	{0x0829, 0x082D, propExtend | propConjunct},
+	{0x0859, 0x085B, propExtend | propConjunct},
+	{0x0890, 0x0891, propPrepend},
+	{0x0897, 0x089F, propExtend | propConjunct},
+	{0x08CA, 0x08E1, propExtend | propConjunct},
+	{0x08E2, 0x08E2, propPrepend},
+	{0x08E3, 0x0902, propExtend | propConjunct},
+	{0x0903, 0x0903, propSpacingMark},
+	{0x0915, 0x0939, propConsonant},
+	{0x093A, 0x093A, propExtend | propConjunct},
+	{0x093B, 0x093B, propSpacingMark},
+	{0x093C, 0x093C, propExtend | propConjunct},
+	{0x093E, 0x0940, propSpacingMark},
+	{0x0941, 0x0948, propExtend | propConjunct},
+	{0x0949, 0x094C, propSpacingMark},
+	{0x094D, 0x094D, propExtend | propLinker},
+	{0x094E, 0x094F, propSpacingMark},
+	{0x0951, 0x0957, propExtend | propConjunct},
+	{0x0958, 0x095F, propConsonant},
+	{0x0962, 0x0963, propExtend | propConjunct},
+	{0x0978, 0x097F, propConsonant},
+	{0x0981, 0x0981, propExtend | propConjunct},
+	{0x0982, 0x0983, propSpacingMark},
+	{0x0995, 0x09A8, propConsonant},
+	{0x09AA, 0x09B0, propConsonant},
+	{0x09B2, 0x09B2, propConsonant},
+	{0x09B6, 0x09B9, propConsonant},
+	{0x09BC, 0x09BC, propExtend | propConjunct},
+	{0x09BE, 0x09BE, propExtend | propConjunct},
+	{0x09BF, 0x09C0, propSpacingMark},
+	{0x09C1, 0x09C4, propExtend | propConjunct},
+	{0x09C7, 0x09C8, propSpacingMark},
+	{0x09CB, 0x09CC, propSpacingMark},
+	{0x09CD, 0x09CD, propExtend | propLinker},
+	{0x09D7, 0x09D7, propExtend | propConjunct},
+	{0x09DC, 0x09DD, propConsonant},
+	{0x09DF, 0x09DF, propConsonant},
+	{0x09E2, 0x09E3, propExtend | propConjunct},
+	{0x09F0, 0x09F1, propConsonant},
+	{0x09FE, 0x09FE, propExtend | propConjunct},
+	{0x0A01, 0x0A02, propExtend | propConjunct},
+	{0x0A03, 0x0A03, propSpacingMark},
+	{0x0A3C, 0x0A3C, propExtend | propConjunct},
+	{0x0A3E, 0x0A40, propSpacingMark},
+	{0x0A41, 0x0A42, propExtend | propConjunct},
+	{0x0A47, 0x0A48, propExtend | propConjunct},
+	{0x0A4B, 0x0A4D, propExtend | propConjunct},
+	{0x0A51, 0x0A51, propExtend | propConjunct},
+	{0x0A70, 0x0A71, propExtend | propConjunct},
+	{0x0A75, 0x0A75, propExtend | propConjunct},
+	{0x0A81, 0x0A82, propExtend | propConjunct},
+	{0x0A83, 0x0A83, propSpacingMark},
+	{0x0A95, 0x0AA8, propConsonant},
+	{0x0AAA, 0x0AB0, propConsonant},
+	{0x0AB2, 0x0AB3, propConsonant},
+	{0x0AB5, 0x0AB9, propConsonant},
+	{0x0ABC, 0x0ABC, propExtend | propConjunct},
+	{0x0ABE, 0x0AC0, propSpacingMark},
+	{0x0AC1, 0x0AC5, propExtend | propConjunct},
+	{0x0AC7, 0x0AC8, propExtend | propConjunct},
+	{0x0AC9, 0x0AC9, propSpacingMark},
+	{0x0ACB, 0x0ACC, propSpacingMark},
+	{0x0ACD, 0x0ACD, propExtend | propLinker},
+	{0x0AE2, 0x0AE3, propExtend | propConjunct},
+	{0x0AF9, 0x0AF9, propConsonant},
+	{0x0AFA, 0x0AFF, propExtend | propConjunct},
+	{0x0B01, 0x0B01, propExtend | propConjunct},
+	{0x0B02, 0x0B03, propSpacingMark},
+	{0x0B15, 0x0B28, propConsonant},
+	{0x0B2A, 0x0B30, propConsonant},
+	{0x0B32, 0x0B33, propConsonant},
+	{0x0B35, 0x0B39, propConsonant},
+	{0x0B3C, 0x0B3C, propExtend | propConjunct},
+	{0x0B3E, 0x0B3F, propExtend | propConjunct},
+	{0x0B40, 0x0B40, propSpacingMark},
+	{0x0B41, 0x0B44, propExtend | propConjunct},
+	{0x0B47, 0x0B48, propSpacingMark},
+	{0x0B4B, 0x0B4C, propSpacingMark},
+	{0x0B4D, 0x0B4D, propExtend | propLinker},
+	{0x0B55, 0x0B57, propExtend | propConjunct},
+	{0x0B5C, 0x0B5D, propConsonant},
+	{0x0B5F, 0x0B5F, propConsonant},
+	{0x0B62, 0x0B63, propExtend | propConjunct},
+	{0x0B71, 0x0B71, propConsonant},
+	{0x0B82, 0x0B82, propExtend | propConjunct},
+	{0x0BBE, 0x0BBE, propExtend | propConjunct},
+	{0x0BBF, 0x0BBF, propSpacingMark},
+	{0x0BC0, 0x0BC0, propExtend | propConjunct},
+	{0x0BC1, 0x0BC2, propSpacingMark},
+	{0x0BC6, 0x0BC8, propSpacingMark},
+	{0x0BCA, 0x0BCC, propSpacingMark},
+	{0x0BCD, 0x0BCD, propExtend | propConjunct},
+	{0x0BD7, 0x0BD7, propExtend | propConjunct},
+	{0x0C00, 0x0C00, propExtend | propConjunct},
+	{0x0C01, 0x0C03, propSpacingMark},
+	{0x0C04, 0x0C04, propExtend | propConjunct},
+	{0x0C15, 0x0C28, propConsonant},
+	{0x0C2A, 0x0C39, propConsonant},
+	{0x0C3C, 0x0C3C, propExtend | propConjunct},
+	{0x0C3E, 0x0C40, propExtend | propConjunct},
+	{0x0C41, 0x0C44, propSpacingMark},
+	{0x0C46, 0x0C48, propExtend | propConjunct},
+	{0x0C4A, 0x0C4C, propExtend | propConjunct},
+	{0x0C4D, 0x0C4D, propExtend | propLinker},
+	{0x0C55, 0x0C56, propExtend | propConjunct},
+	{0x0C58, 0x0C5A, propConsonant},
+	{0x0C62, 0x0C63, propExtend | propConjunct},
+	{0x0C81, 0x0C81, propExtend | propConjunct},
+	{0x0C82, 0x0C83, propSpacingMark},
+	{0x0CBC, 0x0CBC, propExtend | propConjunct},
+	{0x0CBE, 0x0CBE, propSpacingMark},
+	{0x0CBF, 0x0CC0, propExtend | propConjunct},
+	{0x0CC1, 0x0CC1, propSpacingMark},
+	{0x0CC2, 0x0CC2, propExtend | propConjunct},
+	{0x0CC3, 0x0CC4, propSpacingMark},
+	{0x0CC6, 0x0CC8, propExtend | propConjunct},
+	{0x0CCA, 0x0CCD, propExtend | propConjunct},
+	{0x0CD5, 0x0CD6, propExtend | propConjunct},
+	{0x0CE2, 0x0CE3, propExtend | propConjunct},
+	{0x0CF3, 0x0CF3, propSpacingMark},
+	{0x0D00, 0x0D01, propExtend | propConjunct},
+	{0x0D02, 0x0D03, propSpacingMark},
+	{0x0D15, 0x0D3A, propConsonant},
+	{0x0D3B, 0x0D3C, propExtend | propConjunct},
+	{0x0D3E, 0x0D3E, propExtend | propConjunct},
+	{0x0D3F, 0x0D40, propSpacingMark},
+	{0x0D41, 0x0D44, propExtend | propConjunct},
+	{0x0D46, 0x0D48, propSpacingMark},
+	{0x0D4A, 0x0D4C, propSpacingMark},
+	{0x0D4D, 0x0D4D, propExtend | propLinker},
+	{0x0D4E, 0x0D4E, propPrepend},
+	{0x0D57, 0x0D57, propExtend | propConjunct},
+	{0x0D62, 0x0D63, propExtend | propConjunct},
+	{0x0D81, 0x0D81, propExtend | propConjunct},
+	{0x0D82, 0x0D83, propSpacingMark},
+	{0x0DCA, 0x0DCA, propExtend | propConjunct},
+	{0x0DCF, 0x0DCF, propExtend | propConjunct},
+	{0x0DD0, 0x0DD1, propSpacingMark},
+	{0x0DD2, 0x0DD4, propExtend | propConjunct},
+	{0x0DD6, 0x0DD6, propExtend | propConjunct},
+	{0x0DD8, 0x0DDE, propSpacingMark},
+	{0x0DDF, 0x0DDF, propExtend | propConjunct},
+	{0x0DF2, 0x0DF3, propSpacingMark},
+	{0x0E31, 0x0E31, propExtend | propConjunct},
+	{0x0E33, 0x0E33, propSpacingMark},
+	{0x0E34, 0x0E3A, propExtend | propConjunct},
+	{0x0E47, 0x0E4E, propExtend | propConjunct},
+	{0x0EB1, 0x0EB1, propExtend | propConjunct},
+	{0x0EB3, 0x0EB3, propSpacingMark},
+	{0x0EB4, 0x0EBC, propExtend | propConjunct},
+	{0x0EC8, 0x0ECE, propExtend | propConjunct},
+	{0x0F18, 0x0F19, propExtend | propConjunct},
+	{0x0F35, 0x0F35, propExtend | propConjunct},
+	{0x0F37, 0x0F37, propExtend | propConjunct},
+	{0x0F39, 0x0F39, propExtend | propConjunct},
+	{0x0F3E, 0x0F3F, propSpacingMark},
+	{0x0F71, 0x0F7E, propExtend | propConjunct},
+	{0x0F7F, 0x0F7F, propSpacingMark},
+	{0x0F80, 0x0F84, propExtend | propConjunct},
+	{0x0F86, 0x0F87, propExtend | propConjunct},
+	{0x0F8D, 0x0F97, propExtend | propConjunct},
+	{0x0F99, 0x0FBC, propExtend | propConjunct},
+	{0x0FC6, 0x0FC6, propExtend | propConjunct},
+	{0x1000, 0x102A, propConsonant},
+	{0x102D, 0x1030, propExtend | propConjunct},
+	{0x1031, 0x1031, propSpacingMark},
+	{0x1032, 0x1037, propExtend | propConjunct},
+	{0x1039, 0x1039, propExtend | propLinker},
+	{0x103A, 0x103A, propExtend | propConjunct},
+	{0x103B, 0x103C, propSpacingMark},
+	{0x103D, 0x103E, propExtend | propConjunct},
+	{0x103F, 0x103F, propConsonant},
+	{0x1050, 0x1055, propConsonant},
+	{0x1056, 0x1057, propSpacingMark},
+	{0x1058, 0x1059, propExtend | propConjunct},
+	{0x105A, 0x105D, propConsonant},
+	{0x105E, 0x1060, propExtend | propConjunct},
+	{0x1061, 0x1061, propConsonant},
+	{0x1065, 0x1066, propConsonant},
+	{0x106E, 0x1070, propConsonant},
+	{0x1071, 0x1074, propExtend | propConjunct},
+	{0x1075, 0x1081, propConsonant},
+	{0x1082, 0x1082, propExtend | propConjunct},
+	{0x1084, 0x1084, propSpacingMark},
+	{0x1085, 0x1086, propExtend | propConjunct},
+	{0x108D, 0x108D, propExtend | propConjunct},
+	{0x108E, 0x108E, propConsonant},
+	{0x109D, 0x109D, propExtend | propConjunct},
+	{0x1100, 0x115F, propL},
+	{0x1160, 0x11A7, propV},
+	{0x11A8, 0x11FF, propT},
+	{0x135D, 0x135F, propExtend | propConjunct},
+	{0x1712, 0x1715, propExtend | propConjunct},
+	{0x1732, 0x1734, propExtend | propConjunct},
+	{0x1752, 0x1753, propExtend | propConjunct},
+	{0x1772, 0x1773, propExtend | propConjunct},
+	{0x1780, 0x17B3, propConsonant},
+	{0x17B4, 0x17B5, propExtend | propConjunct},
+	{0x17B6, 0x17B6, propSpacingMark},
+	{0x17B7, 0x17BD, propExtend | propConjunct},
+	{0x17BE, 0x17C5, propSpacingMark},
+	{0x17C6, 0x17C6, propExtend | propConjunct},
+	{0x17C7, 0x17C8, propSpacingMark},
+	{0x17C9, 0x17D1, propExtend | propConjunct},
+	{0x17D2, 0x17D2, propExtend | propLinker},
+	{0x17D3, 0x17D3, propExtend | propConjunct},
+	{0x17DD, 0x17DD, propExtend | propConjunct},
+	{0x180B, 0x180D, propExtend | propConjunct},
+	{0x180E, 0x180E, propControl},
+	{0x180F, 0x180F, propExtend | propConjunct},
+	{0x1885, 0x1886, propExtend | propConjunct},
+	{0x18A9, 0x18A9, propExtend | propConjunct},
+	{0x1920, 0x1922, propExtend | propConjunct},
+	{0x1923, 0x1926, propSpacingMark},
+	{0x1927, 0x1928, propExtend | propConjunct},
+	{0x1929, 0x192B, propSpacingMark},
+	{0x1930, 0x1931, propSpacingMark},
+	{0x1932, 0x1932, propExtend | propConjunct},
+	{0x1933, 0x1938, propSpacingMark},
+	{0x1939, 0x193B, propExtend | propConjunct},
+	{0x1A17, 0x1A18, propExtend | propConjunct},
+	{0x1A19, 0x1A1A, propSpacingMark},
+	{0x1A1B, 0x1A1B, propExtend | propConjunct},
+	{0x1A20, 0x1A54, propConsonant},
+	{0x1A55, 0x1A55, propSpacingMark},
+	{0x1A56, 0x1A56, propExtend | propConjunct},
+	{0x1A57, 0x1A57, propSpacingMark},
+	{0x1A58, 0x1A5E, propExtend | propConjunct},
+	{0x1A60, 0x1A60, propExtend | propLinker},
+	{0x1A62, 0x1A62, propExtend | propConjunct},
+	{0x1A65, 0x1A6C, propExtend | propConjunct},
+	{0x1A6D, 0x1A72, propSpacingMark},
+	{0x1A73, 0x1A7C, propExtend | propConjunct},
+	{0x1A7F, 0x1A7F, propExtend | propConjunct},
+	{0x1AB0, 0x1ADD, propExtend | propConjunct},
+	{0x1AE0, 0x1AEB, propExtend | propConjunct},
+	{0x1B00, 0x1B03, propExtend | propConjunct},
+	{0x1B04, 0x1B04, propSpacingMark},
+	{0x1B0B, 0x1B0C, propConsonant},
+	{0x1B13, 0x1B33, propConsonant},
+	{0x1B34, 0x1B3D, propExtend | propConjunct},
+	{0x1B3E, 0x1B41, propSpacingMark},
+	{0x1B42, 0x1B43, propExtend | propConjunct},
+	{0x1B44, 0x1B44, propExtend | propLinker},
+	{0x1B45, 0x1B4C, propConsonant},
+	{0x1B6B, 0x1B73, propExtend | propConjunct},
+	{0x1B80, 0x1B81, propExtend | propConjunct},
+	{0x1B82, 0x1B82, propSpacingMark},
+	{0x1B83, 0x1BA0, propConsonant},
+	{0x1BA1, 0x1BA1, propSpacingMark},
+	{0x1BA2, 0x1BA5, propExtend | propConjunct},
+	{0x1BA6, 0x1BA7, propSpacingMark},
+	{0x1BA8, 0x1BAA, propExtend | propConjunct},
+	{0x1BAB, 0x1BAB, propExtend | propLinker},
+	{0x1BAC, 0x1BAD, propExtend | propConjunct},
+	{0x1BAE, 0x1BAF, propConsonant},
+	{0x1BBB, 0x1BBD, propConsonant},
+	{0x1BE6, 0x1BE6, propExtend | propConjunct},
+	{0x1BE7, 0x1BE7, propSpacingMark},
+	{0x1BE8, 0x1BE9, propExtend | propConjunct},
+	{0x1BEA, 0x1BEC, propSpacingMark},
+	{0x1BED, 0x1BED, propExtend | propConjunct},
+	{0x1BEE, 0x1BEE, propSpacingMark},
+	{0x1BEF, 0x1BF3, propExtend | propConjunct},
+	{0x1C24, 0x1C2B, propSpacingMark},
+	{0x1C2C, 0x1C33, propExtend | propConjunct},
+	{0x1C34, 0x1C35, propSpacingMark},
+	{0x1C36, 0x1C37, propExtend | propConjunct},
+	{0x1CD0, 0x1CD2, propExtend | propConjunct},
+	{0x1CD4, 0x1CE0, propExtend | propConjunct},
+	{0x1CE1, 0x1CE1, propSpacingMark},
+	{0x1CE2, 0x1CE8, propExtend | propConjunct},
+	{0x1CED, 0x1CED, propExtend | propConjunct},
+	{0x1CF4, 0x1CF4, propExtend | propConjunct},
+	{0x1CF7, 0x1CF7, propSpacingMark},
+	{0x1CF8, 0x1CF9, propExtend | propConjunct},
+	{0x1DC0, 0x1DFF, propExtend | propConjunct},
+	{0x200B, 0x200B, propControl},
+	{0x200C, 0x200C, propExtend},
+	{0x200D, 0x200D, propZWJ | propConjunct},
+	{0x200E, 0x200F, propControl},
+	{0x2028, 0x202E, propControl},
+	{0x203C, 0x203C, propExtPict},
+	{0x2049, 0x2049, propExtPict},
+	{0x2060, 0x206F, propControl},
+	{0x20D0, 0x20F0, propExtend | propConjunct},
+	{0x2122, 0x2122, propExtPict},
+	{0x2139, 0x2139, propExtPict},
+	{0x2194, 0x2199, propExtPict},
+	{0x21A9, 0x21AA, propExtPict},
+	{0x231A, 0x231B, propExtPict},
+	{0x2328, 0x2328, propExtPict},
+	{0x23CF, 0x23CF, propExtPict},
+	{0x23E9, 0x23F3, propExtPict},
+	{0x23F8, 0x23FA, propExtPict},
+	{0x24C2, 0x24C2, propExtPict},
+	{0x25AA, 0x25AB, propExtPict},
+	{0x25B6, 0x25B6, propExtPict},
+	{0x25C0, 0x25C0, propExtPict},
+	{0x25FB, 0x25FE, propExtPict},
+	{0x2600, 0x2604, propExtPict},
+	{0x260E, 0x260E, propExtPict},
+	{0x2611, 0x2611, propExtPict},
+	{0x2614, 0x2615, propExtPict},
+	{0x2618, 0x2618, propExtPict},
+	{0x261D, 0x261D, propExtPict},
+	{0x2620, 0x2620, propExtPict},
+	{0x2622, 0x2623, propExtPict},
+	{0x2626, 0x2626, propExtPict},
+	{0x262A, 0x262A, propExtPict},
+	{0x262E, 0x262F, propExtPict},
+	{0x2638, 0x263A, propExtPict},
+	{0x2640, 0x2640, propExtPict},
+	{0x2642, 0x2642, propExtPict},
+	{0x2648, 0x2653, propExtPict},
+	{0x265F, 0x2660, propExtPict},
+	{0x2663, 0x2663, propExtPict},
+	{0x2665, 0x2666, propExtPict},
+	{0x2668, 0x2668, propExtPict},
+	{0x267B, 0x267B, propExtPict},
+	{0x267E, 0x267F, propExtPict},
+	{0x2692, 0x2697, propExtPict},
+	{0x2699, 0x2699, propExtPict},
+	{0x269B, 0x269C, propExtPict},
+	{0x26A0, 0x26A1, propExtPict},
+	{0x26A7, 0x26A7, propExtPict},
+	{0x26AA, 0x26AB, propExtPict},
+	{0x26B0, 0x26B1, propExtPict},
+	{0x26BD, 0x26BE, propExtPict},
+	{0x26C4, 0x26C5, propExtPict},
+	{0x26C8, 0x26C8, propExtPict},
+	{0x26CE, 0x26CF, propExtPict},
+	{0x26D1, 0x26D1, propExtPict},
+	{0x26D3, 0x26D4, propExtPict},
+	{0x26E9, 0x26EA, propExtPict},
+	{0x26F0, 0x26F5, propExtPict},
+	{0x26F7, 0x26FA, propExtPict},
+	{0x26FD, 0x26FD, propExtPict},
+	{0x2702, 0x2702, propExtPict},
+	{0x2705, 0x2705, propExtPict},
+	{0x2708, 0x270D, propExtPict},
+	{0x270F, 0x270F, propExtPict},
+	{0x2712, 0x2712, propExtPict},
+	{0x2714, 0x2714, propExtPict},
+	{0x2716, 0x2716, propExtPict},
+	{0x271D, 0x271D, propExtPict},
+	{0x2721, 0x2721, propExtPict},
+	{0x2728, 0x2728, propExtPict},
+	{0x2733, 0x2734, propExtPict},
+	{0x2744, 0x2744, propExtPict},
+	{0x2747, 0x2747, propExtPict},
+	{0x274C, 0x274C, propExtPict},
+	{0x274E, 0x274E, propExtPict},
+	{0x2753, 0x2755, propExtPict},
+	{0x2757, 0x2757, propExtPict},
+	{0x2763, 0x2764, propExtPict},
+	{0x2795, 0x2797, propExtPict},
+	{0x27A1, 0x27A1, propExtPict},
+	{0x27B0, 0x27B0, propExtPict},
+	{0x27BF, 0x27BF, propExtPict},
+	{0x2934, 0x2935, propExtPict},
+	{0x2B05, 0x2B07, propExtPict},
+	{0x2B1B, 0x2B1C, propExtPict},
+	{0x2B50, 0x2B50, propExtPict},
+	{0x2B55, 0x2B55, propExtPict},
+	{0x2CEF, 0x2CF1, propExtend | propConjunct},
+	{0x2D7F, 0x2D7F, propExtend | propConjunct},
+	{0x2DE0, 0x2DFF, propExtend | propConjunct},
+	{0x302A, 0x302F, propExtend | propConjunct},
+	{0x3030, 0x3030, propExtPict},
+	{0x303D, 0x303D, propExtPict},
+	{0x3099, 0x309A, propExtend | propConjunct},
+	{0x3297, 0x3297, propExtPict},
+	{0x3299, 0x3299, propExtPict},
+	{0xA66F, 0xA672, propExtend | propConjunct},
+	{0xA674, 0xA67D, propExtend | propConjunct},
+	{0xA69E, 0xA69F, propExtend | propConjunct},
+	{0xA6F0, 0xA6F1, propExtend | propConjunct},
+	{0xA802, 0xA802, propExtend | propConjunct},
+	{0xA806, 0xA806, propExtend | propConjunct},
+	{0xA80B, 0xA80B, propExtend | propConjunct},
+	{0xA823, 0xA824, propSpacingMark},
+	{0xA825, 0xA826, propExtend | propConjunct},
+	{0xA827, 0xA827, propSpacingMark},
+	{0xA82C, 0xA82C, propExtend | propConjunct},
+	{0xA880, 0xA881, propSpacingMark},
+	{0xA8B4, 0xA8C3, propSpacingMark},
+	{0xA8C4, 0xA8C5, propExtend | propConjunct},
+	{0xA8E0, 0xA8F1, propExtend | propConjunct},
+	{0xA8FF, 0xA8FF, propExtend | propConjunct},
+	{0xA926, 0xA92D, propExtend | propConjunct},
+	{0xA947, 0xA951, propExtend | propConjunct},
+	{0xA952, 0xA952, propSpacingMark},
+	{0xA953, 0xA953, propExtend | propConjunct},
+	{0xA960, 0xA97C, propL},
+	{0xA980, 0xA982, propExtend | propConjunct},
+	{0xA983, 0xA983, propSpacingMark},
+	{0xA989, 0xA98B, propConsonant},
+	{0xA98F, 0xA9B2, propConsonant},
+	{0xA9B3, 0xA9B3, propExtend | propConjunct},
+	{0xA9B4, 0xA9B5, propSpacingMark},
+	{0xA9B6, 0xA9B9, propExtend | propConjunct},
+	{0xA9BA, 0xA9BB, propSpacingMark},
+	{0xA9BC, 0xA9BD, propExtend | propConjunct},
+	{0xA9BE, 0xA9BF, propSpacingMark},
+	{0xA9C0, 0xA9C0, propExtend | propLinker},
+	{0xA9E0, 0xA9E4, propConsonant},
+	{0xA9E5, 0xA9E5, propExtend | propConjunct},
+	{0xA9E7, 0xA9EF, propConsonant},
+	{0xA9FA, 0xA9FE, propConsonant},
+	{0xAA29, 0xAA2E, propExtend | propConjunct},
+	{0xAA2F, 0xAA30, propSpacingMark},
+	{0xAA31, 0xAA32, propExtend | propConjunct},
+	{0xAA33, 0xAA34, propSpacingMark},
+	{0xAA35, 0xAA36, propExtend | propConjunct},
+	{0xAA43, 0xAA43, propExtend | propConjunct},
+	{0xAA4C, 0xAA4C, propExtend | propConjunct},
+	{0xAA4D, 0xAA4D, propSpacingMark},
+	{0xAA60, 0xAA6F, propConsonant},
+	{0xAA71, 0xAA73, propConsonant},
+	{0xAA7A, 0xAA7A, propConsonant},
+	{0xAA7C, 0xAA7C, propExtend | propConjunct},
+	{0xAA7E, 0xAA7F, propConsonant},
+	{0xAAB0, 0xAAB0, propExtend | propConjunct},
+	{0xAAB2, 0xAAB4, propExtend | propConjunct},
+	{0xAAB7, 0xAAB8, propExtend | propConjunct},
+	{0xAABE, 0xAABF, propExtend | propConjunct},
+	{0xAAC1, 0xAAC1, propExtend | propConjunct},
+	{0xAAE0, 0xAAEA, propConsonant},
+	{0xAAEB, 0xAAEB, propSpacingMark},
+	{0xAAEC, 0xAAED, propExtend | propConjunct},
+	{0xAAEE, 0xAAEF, propSpacingMark},
+	{0xAAF5, 0xAAF5, propSpacingMark},
+	{0xAAF6, 0xAAF6, propExtend | propLinker},
+	{0xABC0, 0xABDA, propConsonant},
+	{0xABE3, 0xABE4, propSpacingMark},
+	{0xABE5, 0xABE5, propExtend | propConjunct},
+	{0xABE6, 0xABE7, propSpacingMark},
+	{0xABE8, 0xABE8, propExtend | propConjunct},
+	{0xABE9, 0xABEA, propSpacingMark},
+	{0xABEC, 0xABEC, propSpacingMark},
+	{0xABED, 0xABED, propExtend | propConjunct},
+	{0xAC00, 0xAC00, propLV},
+	{0xAC01, 0xAC1B, propLVT},
+	{0xAC1C, 0xAC1C, propLV},
+	{0xAC1D, 0xAC37, propLVT},
+	{0xAC38, 0xAC38, propLV},
+	{0xAC39, 0xAC53, propLVT},
+	{0xAC54, 0xAC54, propLV},
+	{0xAC55, 0xAC6F, propLVT},
+	{0xAC70, 0xAC70, propLV},
+	{0xAC71, 0xAC8B, propLVT},
+	{0xAC8C, 0xAC8C, propLV},
+	{0xAC8D, 0xACA7, propLVT},
+	{0xACA8, 0xACA8, propLV},
+	{0xACA9, 0xACC3, propLVT},
+	{0xACC4, 0xACC4, propLV},
+	{0xACC5, 0xACDF, propLVT},
+	{0xACE0, 0xACE0, propLV},
+	{0xACE1, 0xACFB, propLVT},
+	{0xACFC, 0xACFC, propLV},
+	{0xACFD, 0xAD17, propLVT},
+	{0xAD18, 0xAD18, propLV},
+	{0xAD19, 0xAD33, propLVT},
+	{0xAD34, 0xAD34, propLV},
+	{0xAD35, 0xAD4F, propLVT},
+	{0xAD50, 0xAD50, propLV},
+	{0xAD51, 0xAD6B, propLVT},
+	{0xAD6C, 0xAD6C, propLV},
+	{0xAD6D, 0xAD87, propLVT},
+	{0xAD88, 0xAD88, propLV},
+	{0xAD89, 0xADA3, propLVT},
+	{0xADA4, 0xADA4, propLV},
+	{0xADA5, 0xADBF, propLVT},
+	{0xADC0, 0xADC0, propLV},
+	{0xADC1, 0xADDB, propLVT},
+	{0xADDC, 0xADDC, propLV},
+	{0xADDD, 0xADF7, propLVT},
+	{0xADF8, 0xADF8, propLV},
+	{0xADF9, 0xAE13, propLVT},
+	{0xAE14, 0xAE14, propLV},
+	{0xAE15, 0xAE2F, propLVT},
+	{0xAE30, 0xAE30, propLV},
+	{0xAE31, 0xAE4B, propLVT},
+	{0xAE4C, 0xAE4C, propLV},
+	{0xAE4D, 0xAE67, propLVT},
+	{0xAE68, 0xAE68, propLV},
+	{0xAE69, 0xAE83, propLVT},
+	{0xAE84, 0xAE84, propLV},
+	{0xAE85, 0xAE9F, propLVT},
+	{0xAEA0, 0xAEA0, propLV},
+	{0xAEA1, 0xAEBB, propLVT},
+	{0xAEBC, 0xAEBC, propLV},
+	{0xAEBD, 0xAED7, propLVT},
+	{0xAED8, 0xAED8, propLV},
+	{0xAED9, 0xAEF3, propLVT},
+	{0xAEF4, 0xAEF4, propLV},
+	{0xAEF5, 0xAF0F, propLVT},
+	{0xAF10, 0xAF10, propLV},
+	{0xAF11, 0xAF2B, propLVT},
+	{0xAF2C, 0xAF2C, propLV},
+	{0xAF2D, 0xAF47, propLVT},
+	{0xAF48, 0xAF48, propLV},
+	{0xAF49, 0xAF63, propLVT},
+	{0xAF64, 0xAF64, propLV},
+	{0xAF65, 0xAF7F, propLVT},
+	{0xAF80, 0xAF80, propLV},
+	{0xAF81, 0xAF9B, propLVT},
+	{0xAF9C, 0xAF9C, propLV},
+	{0xAF9D, 0xAFB7, propLVT},
+	{0xAFB8, 0xAFB8, propLV},
+	{0xAFB9, 0xAFD3, propLVT},
+	{0xAFD4, 0xAFD4, propLV},
+	{0xAFD5, 0xAFEF, propLVT},
+	{0xAFF0, 0xAFF0, propLV},
+	{0xAFF1, 0xB00B, propLVT},
+	{0xB00C, 0xB00C, propLV},
+	{0xB00D, 0xB027, propLVT},
+	{0xB028, 0xB028, propLV},
+	{0xB029, 0xB043, propLVT},
+	{0xB044, 0xB044, propLV},
+	{0xB045, 0xB05F, propLVT},
+	{0xB060, 0xB060, propLV},
+	{0xB061, 0xB07B, propLVT},
+	{0xB07C, 0xB07C, propLV},
+	{0xB07D, 0xB097, propLVT},
+	{0xB098, 0xB098, propLV},
+	{0xB099, 0xB0B3, propLVT},
+	{0xB0B4, 0xB0B4, propLV},
+	{0xB0B5, 0xB0CF, propLVT},
+	{0xB0D0, 0xB0D0, propLV},
+	{0xB0D1, 0xB0EB, propLVT},
+	{0xB0EC, 0xB0EC, propLV},
+	{0xB0ED, 0xB107, propLVT},
+	{0xB108, 0xB108, propLV},
+	{0xB109, 0xB123, propLVT},
+	{0xB124, 0xB124, propLV},
+	{0xB125, 0xB13F, propLVT},
+	{0xB140, 0xB140, propLV},
+	{0xB141, 0xB15B, propLVT},
+	{0xB15C, 0xB15C, propLV},
+	{0xB15D, 0xB177, propLVT},
+	{0xB178, 0xB178, propLV},
+	{0xB179, 0xB193, propLVT},
+	{0xB194, 0xB194, propLV},
+	{0xB195, 0xB1AF, propLVT},
+	{0xB1B0, 0xB1B0, propLV},
+	{0xB1B1, 0xB1CB, propLVT},
+	{0xB1CC, 0xB1CC, propLV},
+	{0xB1CD, 0xB1E7, propLVT},
+	{0xB1E8, 0xB1E8, propLV},
+	{0xB1E9, 0xB203, propLVT},
+	{0xB204, 0xB204, propLV},
+	{0xB205, 0xB21F, propLVT},
+	{0xB220, 0xB220, propLV},
+	{0xB221, 0xB23B, propLVT},
+	{0xB23C, 0xB23C, propLV},
+	{0xB23D, 0xB257, propLVT},
+	{0xB258, 0xB258, propLV},
+	{0xB259, 0xB273, propLVT},
+	{0xB274, 0xB274, propLV},
+	{0xB275, 0xB28F, propLVT},
+	{0xB290, 0xB290, propLV},
+	{0xB291, 0xB2AB, propLVT},
+	{0xB2AC, 0xB2AC, propLV},
+	{0xB2AD, 0xB2C7, propLVT},
+	{0xB2C8, 0xB2C8, propLV},
+	{0xB2C9, 0xB2E3, propLVT},
+	{0xB2E4, 0xB2E4, propLV},
+	{0xB2E5, 0xB2FF, propLVT},
+	{0xB300, 0xB300, propLV},
+	{0xB301, 0xB31B, propLVT},
+	{0xB31C, 0xB31C, propLV},
+	{0xB31D, 0xB337, propLVT},
+	{0xB338, 0xB338, propLV},
+	{0xB339, 0xB353, propLVT},
+	{0xB354, 0xB354, propLV},
+	{0xB355, 0xB36F, propLVT},
+	{0xB370, 0xB370, propLV},
+	{0xB371, 0xB38B, propLVT},
+	{0xB38C, 0xB38C, propLV},
+	{0xB38D, 0xB3A7, propLVT},
+	{0xB3A8, 0xB3A8, propLV},
+	{0xB3A9, 0xB3C3, propLVT},
+	{0xB3C4, 0xB3C4, propLV},
+	{0xB3C5, 0xB3DF, propLVT},
+	{0xB3E0, 0xB3E0, propLV},
+	{0xB3E1, 0xB3FB, propLVT},
+	{0xB3FC, 0xB3FC, propLV},
+	{0xB3FD, 0xB417, propLVT},
+	{0xB418, 0xB418, propLV},
+	{0xB419, 0xB433, propLVT},
+	{0xB434, 0xB434, propLV},
+	{0xB435, 0xB44F, propLVT},
+	{0xB450, 0xB450, propLV},
+	{0xB451, 0xB46B, propLVT},
+	{0xB46C, 0xB46C, propLV},
+	{0xB46D, 0xB487, propLVT},
+	{0xB488, 0xB488, propLV},
+	{0xB489, 0xB4A3, propLVT},
+	{0xB4A4, 0xB4A4, propLV},
+	{0xB4A5, 0xB4BF, propLVT},
+	{0xB4C0, 0xB4C0, propLV},
+	{0xB4C1, 0xB4DB, propLVT},
+	{0xB4DC, 0xB4DC, propLV},
+	{0xB4DD, 0xB4F7, propLVT},
+	{0xB4F8, 0xB4F8, propLV},
+	{0xB4F9, 0xB513, propLVT},
+	{0xB514, 0xB514, propLV},
+	{0xB515, 0xB52F, propLVT},
+	{0xB530, 0xB530, propLV},
+	{0xB531, 0xB54B, propLVT},
+	{0xB54C, 0xB54C, propLV},
+	{0xB54D, 0xB567, propLVT},
+	{0xB568, 0xB568, propLV},
+	{0xB569, 0xB583, propLVT},
+	{0xB584, 0xB584, propLV},
+	{0xB585, 0xB59F, propLVT},
+	{0xB5A0, 0xB5A0, propLV},
+	{0xB5A1, 0xB5BB, propLVT},
+	{0xB5BC, 0xB5BC, propLV},
+	{0xB5BD, 0xB5D7, propLVT},
+	{0xB5D8, 0xB5D8, propLV},
+	{0xB5D9, 0xB5F3, propLVT},
+	{0xB5F4, 0xB5F4, propLV},
+	{0xB5F5, 0xB60F, propLVT},
+	{0xB610, 0xB610, propLV},
+	{0xB611, 0xB62B, propLVT},
+	{0xB62C, 0xB62C, propLV},
+	{0xB62D, 0xB647, propLVT},
+	{0xB648, 0xB648, propLV},
+	{0xB649, 0xB663, propLVT},
+	{0xB664, 0xB664, propLV},
+	{0xB665, 0xB67F, propLVT},
+	{0xB680, 0xB680, propLV},
+	{0xB681, 0xB69B, propLVT},
+	{0xB69C, 0xB69C, propLV},
+	{0xB69D, 0xB6B7, propLVT},
+	{0xB6B8, 0xB6B8, propLV},
+	{0xB6B9, 0xB6D3, propLVT},
+	{0xB6D4, 0xB6D4, propLV},
+	{0xB6D5, 0xB6EF, propLVT},
+	{0xB6F0, 0xB6F0, propLV},
+	{0xB6F1, 0xB70B, propLVT},
+	{0xB70C, 0xB70C, propLV},
+	{0xB70D, 0xB727, propLVT},
+	{0xB728, 0xB728, propLV},
+	{0xB729, 0xB743, propLVT},
+	{0xB744, 0xB744, propLV},
+	{0xB745, 0xB75F, propLVT},
+	{0xB760, 0xB760, propLV},
+	{0xB761, 0xB77B, propLVT},
+	{0xB77C, 0xB77C, propLV},
+	{0xB77D, 0xB797, propLVT},
+	{0xB798, 0xB798, propLV},
+	{0xB799, 0xB7B3, propLVT},
+	{0xB7B4, 0xB7B4, propLV},
+	{0xB7B5, 0xB7CF, propLVT},
+	{0xB7D0, 0xB7D0, propLV},
+	{0xB7D1, 0xB7EB, propLVT},
+	{0xB7EC, 0xB7EC, propLV},
+	{0xB7ED, 0xB807, propLVT},
+	{0xB808, 0xB808, propLV},
+	{0xB809, 0xB823, propLVT},
+	{0xB824, 0xB824, propLV},
+	{0xB825, 0xB83F, propLVT},
+	{0xB840, 0xB840, propLV},
+	{0xB841, 0xB85B, propLVT},
+	{0xB85C, 0xB85C, propLV},
+	{0xB85D, 0xB877, propLVT},
+	{0xB878, 0xB878, propLV},
+	{0xB879, 0xB893, propLVT},
+	{0xB894, 0xB894, propLV},
+	{0xB895, 0xB8AF, propLVT},
+	{0xB8B0, 0xB8B0, propLV},
+	{0xB8B1, 0xB8CB, propLVT},
+	{0xB8CC, 0xB8CC, propLV},
+	{0xB8CD, 0xB8E7, propLVT},
+	{0xB8E8, 0xB8E8, propLV},
+	{0xB8E9, 0xB903, propLVT},
+	{0xB904, 0xB904, propLV},
+	{0xB905, 0xB91F, propLVT},
+	{0xB920, 0xB920, propLV},
+	{0xB921, 0xB93B, propLVT},
+	{0xB93C, 0xB93C, propLV},
+	{0xB93D, 0xB957, propLVT},
+	{0xB958, 0xB958, propLV},
+	{0xB959, 0xB973, propLVT},
+	{0xB974, 0xB974, propLV},
+	{0xB975, 0xB98F, propLVT},
+	{0xB990, 0xB990, propLV},
+	{0xB991, 0xB9AB, propLVT},
+	{0xB9AC, 0xB9AC, propLV},
+	{0xB9AD, 0xB9C7, propLVT},
+	{0xB9C8, 0xB9C8, propLV},
+	{0xB9C9, 0xB9E3, propLVT},
+	{0xB9E4, 0xB9E4, propLV},
+	{0xB9E5, 0xB9FF, propLVT},
+	{0xBA00, 0xBA00, propLV},
+	{0xBA01, 0xBA1B, propLVT},
+	{0xBA1C, 0xBA1C, propLV},
+	{0xBA1D, 0xBA37, propLVT},
+	{0xBA38, 0xBA38, propLV},
+	{0xBA39, 0xBA53, propLVT},
+	{0xBA54, 0xBA54, propLV},
+	{0xBA55, 0xBA6F, propLVT},
+	{0xBA70, 0xBA70, propLV},
+	{0xBA71, 0xBA8B, propLVT},
+	{0xBA8C, 0xBA8C, propLV},
+	{0xBA8D, 0xBAA7, propLVT},
+	{0xBAA8, 0xBAA8, propLV},
+	{0xBAA9, 0xBAC3, propLVT},
+	{0xBAC4, 0xBAC4, propLV},
+	{0xBAC5, 0xBADF, propLVT},
+	{0xBAE0, 0xBAE0, propLV},
+	{0xBAE1, 0xBAFB, propLVT},
+	{0xBAFC, 0xBAFC, propLV},
+	{0xBAFD, 0xBB17, propLVT},
+	{0xBB18, 0xBB18, propLV},
+	{0xBB19, 0xBB33, propLVT},
+	{0xBB34, 0xBB34, propLV},
+	{0xBB35, 0xBB4F, propLVT},
+	{0xBB50, 0xBB50, propLV},
+	{0xBB51, 0xBB6B, propLVT},
+	{0xBB6C, 0xBB6C, propLV},
+	{0xBB6D, 0xBB87, propLVT},
+	{0xBB88, 0xBB88, propLV},
+	{0xBB89, 0xBBA3, propLVT},
+	{0xBBA4, 0xBBA4, propLV},
+	{0xBBA5, 0xBBBF, propLVT},
+	{0xBBC0, 0xBBC0, propLV},
+	{0xBBC1, 0xBBDB, propLVT},
+	{0xBBDC, 0xBBDC, propLV},
+	{0xBBDD, 0xBBF7, propLVT},
+	{0xBBF8, 0xBBF8, propLV},
+	{0xBBF9, 0xBC13, propLVT},
+	{0xBC14, 0xBC14, propLV},
+	{0xBC15, 0xBC2F, propLVT},
+	{0xBC30, 0xBC30, propLV},
+	{0xBC31, 0xBC4B, propLVT},
+	{0xBC4C, 0xBC4C, propLV},
+	{0xBC4D, 0xBC67, propLVT},
+	{0xBC68, 0xBC68, propLV},
+	{0xBC69, 0xBC83, propLVT},
+	{0xBC84, 0xBC84, propLV},
+	{0xBC85, 0xBC9F, propLVT},
+	{0xBCA0, 0xBCA0, propLV},
+	{0xBCA1, 0xBCBB, propLVT},
+	{0xBCBC, 0xBCBC, propLV},
+	{0xBCBD, 0xBCD7, propLVT},
+	{0xBCD8, 0xBCD8, propLV},
+	{0xBCD9, 0xBCF3, propLVT},
+	{0xBCF4, 0xBCF4, propLV},
+	{0xBCF5, 0xBD0F, propLVT},
+	{0xBD10, 0xBD10, propLV},
+	{0xBD11, 0xBD2B, propLVT},
+	{0xBD2C, 0xBD2C, propLV},
+	{0xBD2D, 0xBD47, propLVT},
+	{0xBD48, 0xBD48, propLV},
+	{0xBD49, 0xBD63, propLVT},
+	{0xBD64, 0xBD64, propLV},
+	{0xBD65, 0xBD7F, propLVT},
+	{0xBD80, 0xBD80, propLV},
+	{0xBD81, 0xBD9B, propLVT},
+	{0xBD9C, 0xBD9C, propLV},
+	{0xBD9D, 0xBDB7, propLVT},
+	{0xBDB8, 0xBDB8, propLV},
+	{0xBDB9, 0xBDD3, propLVT},
+	{0xBDD4, 0xBDD4, propLV},
+	{0xBDD5, 0xBDEF, propLVT},
+	{0xBDF0, 0xBDF0, propLV},
+	{0xBDF1, 0xBE0B, propLVT},
+	{0xBE0C, 0xBE0C, propLV},
+	{0xBE0D, 0xBE27, propLVT},
+	{0xBE28, 0xBE28, propLV},
+	{0xBE29, 0xBE43, propLVT},
+	{0xBE44, 0xBE44, propLV},
+	{0xBE45, 0xBE5F, propLVT},
+	{0xBE60, 0xBE60, propLV},
+	{0xBE61, 0xBE7B, propLVT},
+	{0xBE7C, 0xBE7C, propLV},
+	{0xBE7D, 0xBE97, propLVT},
+	{0xBE98, 0xBE98, propLV},
+	{0xBE99, 0xBEB3, propLVT},
+	{0xBEB4, 0xBEB4, propLV},
+	{0xBEB5, 0xBECF, propLVT},
+	{0xBED0, 0xBED0, propLV},
+	{0xBED1, 0xBEEB, propLVT},
+	{0xBEEC, 0xBEEC, propLV},
+	{0xBEED, 0xBF07, propLVT},
+	{0xBF08, 0xBF08, propLV},
+	{0xBF09, 0xBF23, propLVT},
+	{0xBF24, 0xBF24, propLV},
+	{0xBF25, 0xBF3F, propLVT},
+	{0xBF40, 0xBF40, propLV},
+	{0xBF41, 0xBF5B, propLVT},
+	{0xBF5C, 0xBF5C, propLV},
+	{0xBF5D, 0xBF77, propLVT},
+	{0xBF78, 0xBF78, propLV},
+	{0xBF79, 0xBF93, propLVT},
+	{0xBF94, 0xBF94, propLV},
+	{0xBF95, 0xBFAF, propLVT},
+	{0xBFB0, 0xBFB0, propLV},
+	{0xBFB1, 0xBFCB, propLVT},
+	{0xBFCC, 0xBFCC, propLV},
+	{0xBFCD, 0xBFE7, propLVT},
+	{0xBFE8, 0xBFE8, propLV},
+	{0xBFE9, 0xC003, propLVT},
+	{0xC004, 0xC004, propLV},
+	{0xC005, 0xC01F, propLVT},
+	{0xC020, 0xC020, propLV},
+	{0xC021, 0xC03B, propLVT},
+	{0xC03C, 0xC03C, propLV},
+	{0xC03D, 0xC057, propLVT},
+	{0xC058, 0xC058, propLV},
+	{0xC059, 0xC073, propLVT},
+	{0xC074, 0xC074, propLV},
+	{0xC075, 0xC08F, propLVT},
+	{0xC090, 0xC090, propLV},
+	{0xC091, 0xC0AB, propLVT},
+	{0xC0AC, 0xC0AC, propLV},
+	{0xC0AD, 0xC0C7, propLVT},
+	{0xC0C8, 0xC0C8, propLV},
+	{0xC0C9, 0xC0E3, propLVT},
+	{0xC0E4, 0xC0E4, propLV},
+	{0xC0E5, 0xC0FF, propLVT},
+	{0xC100, 0xC100, propLV},
+	{0xC101, 0xC11B, propLVT},
+	{0xC11C, 0xC11C, propLV},
+	{0xC11D, 0xC137, propLVT},
+	{0xC138, 0xC138, propLV},
+	{0xC139, 0xC153, propLVT},
+	{0xC154, 0xC154, propLV},
+	{0xC155, 0xC16F, propLVT},
+	{0xC170, 0xC170, propLV},
+	{0xC171, 0xC18B, propLVT},
+	{0xC18C, 0xC18C, propLV},
+	{0xC18D, 0xC1A7, propLVT},
+	{0xC1A8, 0xC1A8, propLV},
+	{0xC1A9, 0xC1C3, propLVT},
+	{0xC1C4, 0xC1C4, propLV},
+	{0xC1C5, 0xC1DF, propLVT},
+	{0xC1E0, 0xC1E0, propLV},
+	{0xC1E1, 0xC1FB, propLVT},
+	{0xC1FC, 0xC1FC, propLV},
+	{0xC1FD, 0xC217, propLVT},
+	{0xC218, 0xC218, propLV},
+	{0xC219, 0xC233, propLVT},
+	{0xC234, 0xC234, propLV},
+	{0xC235, 0xC24F, propLVT},
+	{0xC250, 0xC250, propLV},
+	{0xC251, 0xC26B, propLVT},
+	{0xC26C, 0xC26C, propLV},
+	{0xC26D, 0xC287, propLVT},
+	{0xC288, 0xC288, propLV},
+	{0xC289, 0xC2A3, propLVT},
+	{0xC2A4, 0xC2A4, propLV},
+	{0xC2A5, 0xC2BF, propLVT},
+	{0xC2C0, 0xC2C0, propLV},
+	{0xC2C1, 0xC2DB, propLVT},
+	{0xC2DC, 0xC2DC, propLV},
+	{0xC2DD, 0xC2F7, propLVT},
+	{0xC2F8, 0xC2F8, propLV},
+	{0xC2F9, 0xC313, propLVT},
+	{0xC314, 0xC314, propLV},
+	{0xC315, 0xC32F, propLVT},
+	{0xC330, 0xC330, propLV},
+	{0xC331, 0xC34B, propLVT},
+	{0xC34C, 0xC34C, propLV},
+	{0xC34D, 0xC367, propLVT},
+	{0xC368, 0xC368, propLV},
+	{0xC369, 0xC383, propLVT},
+	{0xC384, 0xC384, propLV},
+	{0xC385, 0xC39F, propLVT},
+	{0xC3A0, 0xC3A0, propLV},
+	{0xC3A1, 0xC3BB, propLVT},
+	{0xC3BC, 0xC3BC, propLV},
+	{0xC3BD, 0xC3D7, propLVT},
+	{0xC3D8, 0xC3D8, propLV},
+	{0xC3D9, 0xC3F3, propLVT},
+	{0xC3F4, 0xC3F4, propLV},
+	{0xC3F5, 0xC40F, propLVT},
+	{0xC410, 0xC410, propLV},
+	{0xC411, 0xC42B, propLVT},
+	{0xC42C, 0xC42C, propLV},
+	{0xC42D, 0xC447, propLVT},
+	{0xC448, 0xC448, propLV},
+	{0xC449, 0xC463, propLVT},
+	{0xC464, 0xC464, propLV},
+	{0xC465, 0xC47F, propLVT},
+	{0xC480, 0xC480, propLV},
+	{0xC481, 0xC49B, propLVT},
+	{0xC49C, 0xC49C, propLV},
+	{0xC49D, 0xC4B7, propLVT},
+	{0xC4B8, 0xC4B8, propLV},
+	{0xC4B9, 0xC4D3, propLVT},
+	{0xC4D4, 0xC4D4, propLV},
+	{0xC4D5, 0xC4EF, propLVT},
+	{0xC4F0, 0xC4F0, propLV},
+	{0xC4F1, 0xC50B, propLVT},
+	{0xC50C, 0xC50C, propLV},
+	{0xC50D, 0xC527, propLVT},
+	{0xC528, 0xC528, propLV},
+	{0xC529, 0xC543, propLVT},
+	{0xC544, 0xC544, propLV},
+	{0xC545, 0xC55F, propLVT},
+	{0xC560, 0xC560, propLV},
+	{0xC561, 0xC57B, propLVT},
+	{0xC57C, 0xC57C, propLV},
+	{0xC57D, 0xC597, propLVT},
+	{0xC598, 0xC598, propLV},
+	{0xC599, 0xC5B3, propLVT},
+	{0xC5B4, 0xC5B4, propLV},
+	{0xC5B5, 0xC5CF, propLVT},
+	{0xC5D0, 0xC5D0, propLV},
+	{0xC5D1, 0xC5EB, propLVT},
+	{0xC5EC, 0xC5EC, propLV},
+	{0xC5ED, 0xC607, propLVT},
+	{0xC608, 0xC608, propLV},
+	{0xC609, 0xC623, propLVT},
+	{0xC624, 0xC624, propLV},
+	{0xC625, 0xC63F, propLVT},
+	{0xC640, 0xC640, propLV},
+	{0xC641, 0xC65B, propLVT},
+	{0xC65C, 0xC65C, propLV},
+	{0xC65D, 0xC677, propLVT},
+	{0xC678, 0xC678, propLV},
+	{0xC679, 0xC693, propLVT},
+	{0xC694, 0xC694, propLV},
+	{0xC695, 0xC6AF, propLVT},
+	{0xC6B0, 0xC6B0, propLV},
+	{0xC6B1, 0xC6CB, propLVT},
+	{0xC6CC, 0xC6CC, propLV},
+	{0xC6CD, 0xC6E7, propLVT},
+	{0xC6E8, 0xC6E8, propLV},
+	{0xC6E9, 0xC703, propLVT},
+	{0xC704, 0xC704, propLV},
+	{0xC705, 0xC71F, propLVT},
+	{0xC720, 0xC720, propLV},
+	{0xC721, 0xC73B, propLVT},
+	{0xC73C, 0xC73C, propLV},
+	{0xC73D, 0xC757, propLVT},
+	{0xC758, 0xC758, propLV},
+	{0xC759, 0xC773, propLVT},
+	{0xC774, 0xC774, propLV},
+	{0xC775, 0xC78F, propLVT},
+	{0xC790, 0xC790, propLV},
+	{0xC791, 0xC7AB, propLVT},
+	{0xC7AC, 0xC7AC, propLV},
+	{0xC7AD, 0xC7C7, propLVT},
+	{0xC7C8, 0xC7C8, propLV},
+	{0xC7C9, 0xC7E3, propLVT},
+	{0xC7E4, 0xC7E4, propLV},
+	{0xC7E5, 0xC7FF, propLVT},
+	{0xC800, 0xC800, propLV},
+	{0xC801, 0xC81B, propLVT},
+	{0xC81C, 0xC81C, propLV},
+	{0xC81D, 0xC837, propLVT},
+	{0xC838, 0xC838, propLV},
+	{0xC839, 0xC853, propLVT},
+	{0xC854, 0xC854, propLV},
+	{0xC855, 0xC86F, propLVT},
+	{0xC870, 0xC870, propLV},
+	{0xC871, 0xC88B, propLVT},
+	{0xC88C, 0xC88C, propLV},
+	{0xC88D, 0xC8A7, propLVT},
+	{0xC8A8, 0xC8A8, propLV},
+	{0xC8A9, 0xC8C3, propLVT},
+	{0xC8C4, 0xC8C4, propLV},
+	{0xC8C5, 0xC8DF, propLVT},
+	{0xC8E0, 0xC8E0, propLV},
+	{0xC8E1, 0xC8FB, propLVT},
+	{0xC8FC, 0xC8FC, propLV},
+	{0xC8FD, 0xC917, propLVT},
+	{0xC918, 0xC918, propLV},
+	{0xC919, 0xC933, propLVT},
+	{0xC934, 0xC934, propLV},
+	{0xC935, 0xC94F, propLVT},
+	{0xC950, 0xC950, propLV},
+	{0xC951, 0xC96B, propLVT},
+	{0xC96C, 0xC96C, propLV},
+	{0xC96D, 0xC987, propLVT},
+	{0xC988, 0xC988, propLV},
+	{0xC989, 0xC9A3, propLVT},
+	{0xC9A4, 0xC9A4, propLV},
+	{0xC9A5, 0xC9BF, propLVT},
+	{0xC9C0, 0xC9C0, propLV},
+	{0xC9C1, 0xC9DB, propLVT},
+	{0xC9DC, 0xC9DC, propLV},
+	{0xC9DD, 0xC9F7, propLVT},
+	{0xC9F8, 0xC9F8, propLV},
+	{0xC9F9, 0xCA13, propLVT},
+	{0xCA14, 0xCA14, propLV},
+	{0xCA15, 0xCA2F, propLVT},
+	{0xCA30, 0xCA30, propLV},
+	{0xCA31, 0xCA4B, propLVT},
+	{0xCA4C, 0xCA4C, propLV},
+	{0xCA4D, 0xCA67, propLVT},
+	{0xCA68, 0xCA68, propLV},
+	{0xCA69, 0xCA83, propLVT},
+	{0xCA84, 0xCA84, propLV},
+	{0xCA85, 0xCA9F, propLVT},
+	{0xCAA0, 0xCAA0, propLV},
+	{0xCAA1, 0xCABB, propLVT},
+	{0xCABC, 0xCABC, propLV},
+	{0xCABD, 0xCAD7, propLVT},
+	{0xCAD8, 0xCAD8, propLV},
+	{0xCAD9, 0xCAF3, propLVT},
+	{0xCAF4, 0xCAF4, propLV},
+	{0xCAF5, 0xCB0F, propLVT},
+	{0xCB10, 0xCB10, propLV},
+	{0xCB11, 0xCB2B, propLVT},
+	{0xCB2C, 0xCB2C, propLV},
+	{0xCB2D, 0xCB47, propLVT},
+	{0xCB48, 0xCB48, propLV},
+	{0xCB49, 0xCB63, propLVT},
+	{0xCB64, 0xCB64, propLV},
+	{0xCB65, 0xCB7F, propLVT},
+	{0xCB80, 0xCB80, propLV},
+	{0xCB81, 0xCB9B, propLVT},
+	{0xCB9C, 0xCB9C, propLV},
+	{0xCB9D, 0xCBB7, propLVT},
+	{0xCBB8, 0xCBB8, propLV},
+	{0xCBB9, 0xCBD3, propLVT},
+	{0xCBD4, 0xCBD4, propLV},
+	{0xCBD5, 0xCBEF, propLVT},
+	{0xCBF0, 0xCBF0, propLV},
+	{0xCBF1, 0xCC0B, propLVT},
+	{0xCC0C, 0xCC0C, propLV},
+	{0xCC0D, 0xCC27, propLVT},
+	{0xCC28, 0xCC28, propLV},
+	{0xCC29, 0xCC43, propLVT},
+	{0xCC44, 0xCC44, propLV},
+	{0xCC45, 0xCC5F, propLVT},
+	{0xCC60, 0xCC60, propLV},
+	{0xCC61, 0xCC7B, propLVT},
+	{0xCC7C, 0xCC7C, propLV},
+	{0xCC7D, 0xCC97, propLVT},
+	{0xCC98, 0xCC98, propLV},
+	{0xCC99, 0xCCB3, propLVT},
+	{0xCCB4, 0xCCB4, propLV},
+	{0xCCB5, 0xCCCF, propLVT},
+	{0xCCD0, 0xCCD0, propLV},
+	{0xCCD1, 0xCCEB, propLVT},
+	{0xCCEC, 0xCCEC, propLV},
+	{0xCCED, 0xCD07, propLVT},
+	{0xCD08, 0xCD08, propLV},
+	{0xCD09, 0xCD23, propLVT},
+	{0xCD24, 0xCD24, propLV},
+	{0xCD25, 0xCD3F, propLVT},
+	{0xCD40, 0xCD40, propLV},
+	{0xCD41, 0xCD5B, propLVT},
+	{0xCD5C, 0xCD5C, propLV},
+	{0xCD5D, 0xCD77, propLVT},
+	{0xCD78, 0xCD78, propLV},
+	{0xCD79, 0xCD93, propLVT},
+	{0xCD94, 0xCD94, propLV},
+	{0xCD95, 0xCDAF, propLVT},
+	{0xCDB0, 0xCDB0, propLV},
+	{0xCDB1, 0xCDCB, propLVT},
+	{0xCDCC, 0xCDCC, propLV},
+	{0xCDCD, 0xCDE7, propLVT},
+	{0xCDE8, 0xCDE8, propLV},
+	{0xCDE9, 0xCE03, propLVT},
+	{0xCE04, 0xCE04, propLV},
+	{0xCE05, 0xCE1F, propLVT},
+	{0xCE20, 0xCE20, propLV},
+	{0xCE21, 0xCE3B, propLVT},
+	{0xCE3C, 0xCE3C, propLV},
+	{0xCE3D, 0xCE57, propLVT},
+	{0xCE58, 0xCE58, propLV},
+	{0xCE59, 0xCE73, propLVT},
+	{0xCE74, 0xCE74, propLV},
+	{0xCE75, 0xCE8F, propLVT},
+	{0xCE90, 0xCE90, propLV},
+	{0xCE91, 0xCEAB, propLVT},
+	{0xCEAC, 0xCEAC, propLV},
+	{0xCEAD, 0xCEC7, propLVT},
+	{0xCEC8, 0xCEC8, propLV},
+	{0xCEC9, 0xCEE3, propLVT},
+	{0xCEE4, 0xCEE4, propLV},
+	{0xCEE5, 0xCEFF, propLVT},
+	{0xCF00, 0xCF00, propLV},
+	{0xCF01, 0xCF1B, propLVT},
+	{0xCF1C, 0xCF1C, propLV},
+	{0xCF1D, 0xCF37, propLVT},
+	{0xCF38, 0xCF38, propLV},
+	{0xCF39, 0xCF53, propLVT},
+	{0xCF54, 0xCF54, propLV},
+	{0xCF55, 0xCF6F, propLVT},
+	{0xCF70, 0xCF70, propLV},
+	{0xCF71, 0xCF8B, propLVT},
+	{0xCF8C, 0xCF8C, propLV},
+	{0xCF8D, 0xCFA7, propLVT},
+	{0xCFA8, 0xCFA8, propLV},
+	{0xCFA9, 0xCFC3, propLVT},
+	{0xCFC4, 0xCFC4, propLV},
+	{0xCFC5, 0xCFDF, propLVT},
+	{0xCFE0, 0xCFE0, propLV},
+	{0xCFE1, 0xCFFB, propLVT},
+	{0xCFFC, 0xCFFC, propLV},
+	{0xCFFD, 0xD017, propLVT},
+	{0xD018, 0xD018, propLV},
+	{0xD019, 0xD033, propLVT},
+	{0xD034, 0xD034, propLV},
+	{0xD035, 0xD04F, propLVT},
+	{0xD050, 0xD050, propLV},
+	{0xD051, 0xD06B, propLVT},
+	{0xD06C, 0xD06C, propLV},
+	{0xD06D, 0xD087, propLVT},
+	{0xD088, 0xD088, propLV},
+	{0xD089, 0xD0A3, propLVT},
+	{0xD0A4, 0xD0A4, propLV},
+	{0xD0A5, 0xD0BF, propLVT},
+	{0xD0C0, 0xD0C0, propLV},
+	{0xD0C1, 0xD0DB, propLVT},
+	{0xD0DC, 0xD0DC, propLV},
+	{0xD0DD, 0xD0F7, propLVT},
+	{0xD0F8, 0xD0F8, propLV},
+	{0xD0F9, 0xD113, propLVT},
+	{0xD114, 0xD114, propLV},
+	{0xD115, 0xD12F, propLVT},
+	{0xD130, 0xD130, propLV},
+	{0xD131, 0xD14B, propLVT},
+	{0xD14C, 0xD14C, propLV},
+	{0xD14D, 0xD167, propLVT},
+	{0xD168, 0xD168, propLV},
+	{0xD169, 0xD183, propLVT},
+	{0xD184, 0xD184, propLV},
+	{0xD185, 0xD19F, propLVT},
+	{0xD1A0, 0xD1A0, propLV},
+	{0xD1A1, 0xD1BB, propLVT},
+	{0xD1BC, 0xD1BC, propLV},
+	{0xD1BD, 0xD1D7, propLVT},
+	{0xD1D8, 0xD1D8, propLV},
+	{0xD1D9, 0xD1F3, propLVT},
+	{0xD1F4, 0xD1F4, propLV},
+	{0xD1F5, 0xD20F, propLVT},
+	{0xD210, 0xD210, propLV},
+	{0xD211, 0xD22B, propLVT},
+	{0xD22C, 0xD22C, propLV},
+	{0xD22D, 0xD247, propLVT},
+	{0xD248, 0xD248, propLV},
+	{0xD249, 0xD263, propLVT},
+	{0xD264, 0xD264, propLV},
+	{0xD265, 0xD27F, propLVT},
+	{0xD280, 0xD280, propLV},
+	{0xD281, 0xD29B, propLVT},
+	{0xD29C, 0xD29C, propLV},
+	{0xD29D, 0xD2B7, propLVT},
+	{0xD2B8, 0xD2B8, propLV},
+	{0xD2B9, 0xD2D3, propLVT},
+	{0xD2D4, 0xD2D4, propLV},
+	{0xD2D5, 0xD2EF, propLVT},
+	{0xD2F0, 0xD2F0, propLV},
+	{0xD2F1, 0xD30B, propLVT},
+	{0xD30C, 0xD30C, propLV},
+	{0xD30D, 0xD327, propLVT},
+	{0xD328, 0xD328, propLV},
+	{0xD329, 0xD343, propLVT},
+	{0xD344, 0xD344, propLV},
+	{0xD345, 0xD35F, propLVT},
+	{0xD360, 0xD360, propLV},
+	{0xD361, 0xD37B, propLVT},
+	{0xD37C, 0xD37C, propLV},
+	{0xD37D, 0xD397, propLVT},
+	{0xD398, 0xD398, propLV},
+	{0xD399, 0xD3B3, propLVT},
+	{0xD3B4, 0xD3B4, propLV},
+	{0xD3B5, 0xD3CF, propLVT},
+	{0xD3D0, 0xD3D0, propLV},
+	{0xD3D1, 0xD3EB, propLVT},
+	{0xD3EC, 0xD3EC, propLV},
+	{0xD3ED, 0xD407, propLVT},
+	{0xD408, 0xD408, propLV},
+	{0xD409, 0xD423, propLVT},
+	{0xD424, 0xD424, propLV},
+	{0xD425, 0xD43F, propLVT},
+	{0xD440, 0xD440, propLV},
+	{0xD441, 0xD45B, propLVT},
+	{0xD45C, 0xD45C, propLV},
+	{0xD45D, 0xD477, propLVT},
+	{0xD478, 0xD478, propLV},
+	{0xD479, 0xD493, propLVT},
+	{0xD494, 0xD494, propLV},
+	{0xD495, 0xD4AF, propLVT},
+	{0xD4B0, 0xD4B0, propLV},
+	{0xD4B1, 0xD4CB, propLVT},
+	{0xD4CC, 0xD4CC, propLV},
+	{0xD4CD, 0xD4E7, propLVT},
+	{0xD4E8, 0xD4E8, propLV},
+	{0xD4E9, 0xD503, propLVT},
+	{0xD504, 0xD504, propLV},
+	{0xD505, 0xD51F, propLVT},
+	{0xD520, 0xD520, propLV},
+	{0xD521, 0xD53B, propLVT},
+	{0xD53C, 0xD53C, propLV},
+	{0xD53D, 0xD557, propLVT},
+	{0xD558, 0xD558, propLV},
+	{0xD559, 0xD573, propLVT},
+	{0xD574, 0xD574, propLV},
+	{0xD575, 0xD58F, propLVT},
+	{0xD590, 0xD590, propLV},
+	{0xD591, 0xD5AB, propLVT},
+	{0xD5AC, 0xD5AC, propLV},
+	{0xD5AD, 0xD5C7, propLVT},
+	{0xD5C8, 0xD5C8, propLV},
+	{0xD5C9, 0xD5E3, propLVT},
+	{0xD5E4, 0xD5E4, propLV},
+	{0xD5E5, 0xD5FF, propLVT},
+	{0xD600, 0xD600, propLV},
+	{0xD601, 0xD61B, propLVT},
+	{0xD61C, 0xD61C, propLV},
+	{0xD61D, 0xD637, propLVT},
+	{0xD638, 0xD638, propLV},
+	{0xD639, 0xD653, propLVT},
+	{0xD654, 0xD654, propLV},
+	{0xD655, 0xD66F, propLVT},
+	{0xD670, 0xD670, propLV},
+	{0xD671, 0xD68B, propLVT},
+	{0xD68C, 0xD68C, propLV},
+	{0xD68D, 0xD6A7, propLVT},
+	{0xD6A8, 0xD6A8, propLV},
+	{0xD6A9, 0xD6C3, propLVT},
+	{0xD6C4, 0xD6C4, propLV},
+	{0xD6C5, 0xD6DF, propLVT},
+	{0xD6E0, 0xD6E0, propLV},
+	{0xD6E1, 0xD6FB, propLVT},
+	{0xD6FC, 0xD6FC, propLV},
+	{0xD6FD, 0xD717, propLVT},
+	{0xD718, 0xD718, propLV},
+	{0xD719, 0xD733, propLVT},
+	{0xD734, 0xD734, propLV},
+	{0xD735, 0xD74F, propLVT},
+	{0xD750, 0xD750, propLV},
+	{0xD751, 0xD76B, propLVT},
+	{0xD76C, 0xD76C, propLV},
+	{0xD76D, 0xD787, propLVT},
+	{0xD788, 0xD788, propLV},
+	{0xD789, 0xD7A3, propLVT},
+	{0xD7B0, 0xD7C6, propV},
+	{0xD7CB, 0xD7FB, propT},
+	{0xFB1E, 0xFB1E, propExtend | propConjunct},
+	{0xFE00, 0xFE0F, propExtend | propConjunct},
+	{0xFE20, 0xFE2F, propExtend | propConjunct},
+	{0xFEFF, 0xFEFF, propControl},
+	{0xFF9E, 0xFF9F, propExtend | propConjunct},
+	{0xFFF0, 0xFFFB, propControl},
+	{0x101FD, 0x101FD, propExtend | propConjunct},
+	{0x102E0, 0x102E0, propExtend | propConjunct},
+	{0x10376, 0x1037A, propExtend | propConjunct},
+	{0x10A00, 0x10A00, propConsonant},
+	{0x10A01, 0x10A03, propExtend | propConjunct},
+	{0x10A05, 0x10A06, propExtend | propConjunct},
+	{0x10A0C, 0x10A0F, propExtend | propConjunct},
+	{0x10A10, 0x10A13, propConsonant},
+	{0x10A15, 0x10A17, propConsonant},
+	{0x10A19, 0x10A35, propConsonant},
+	{0x10A38, 0x10A3A, propExtend | propConjunct},
+	{0x10A3F, 0x10A3F, propExtend | propLinker},
+	{0x10AE5, 0x10AE6, propExtend | propConjunct},
+	{0x10D24, 0x10D27, propExtend | propConjunct},
+	{0x10D69, 0x10D6D, propExtend | propConjunct},
+	{0x10EAB, 0x10EAC, propExtend | propConjunct},
+	{0x10EFA, 0x10EFF, propExtend | propConjunct},
+	{0x10F46, 0x10F50, propExtend | propConjunct},
+	{0x10F82, 0x10F85, propExtend | propConjunct},
+	{0x11000, 0x11000, propSpacingMark},
+	{0x11001, 0x11001, propExtend | propConjunct},
+	{0x11002, 0x11002, propSpacingMark},
+	{0x11038, 0x11046, propExtend | propConjunct},
+	{0x11070, 0x11070, propExtend | propConjunct},
+	{0x11073, 0x11074, propExtend | propConjunct},
+	{0x1107F, 0x11081, propExtend | propConjunct},
+	{0x11082, 0x11082, propSpacingMark},
+	{0x110B0, 0x110B2, propSpacingMark},
+	{0x110B3, 0x110B6, propExtend | propConjunct},
+	{0x110B7, 0x110B8, propSpacingMark},
+	{0x110B9, 0x110BA, propExtend | propConjunct},
+	{0x110BD, 0x110BD, propPrepend},
+	{0x110C2, 0x110C2, propExtend | propConjunct},
+	{0x110CD, 0x110CD, propPrepend},
+	{0x11100, 0x11102, propExtend | propConjunct},
+	{0x11103, 0x11126, propConsonant},
+	{0x11127, 0x1112B, propExtend | propConjunct},
+	{0x1112C, 0x1112C, propSpacingMark},
+	{0x1112D, 0x11132, propExtend | propConjunct},
+	{0x11133, 0x11133, propExtend | propLinker},
+	{0x11134, 0x11134, propExtend | propConjunct},
+	{0x11144, 0x11144, propConsonant},
+	{0x11145, 0x11146, propSpacingMark},
+	{0x11147, 0x11147, propConsonant},
+	{0x11173, 0x11173, propExtend | propConjunct},
+	{0x11180, 0x11181, propExtend | propConjunct},
+	{0x11182, 0x11182, propSpacingMark},
+	{0x111B3, 0x111B5, propSpacingMark},
+	{0x111B6, 0x111BE, propExtend | propConjunct},
+	{0x111BF, 0x111BF, propSpacingMark},
+	{0x111C0, 0x111C0, propExtend | propConjunct},
+	{0x111C2, 0x111C3, propPrepend},
+	{0x111C9, 0x111CC, propExtend | propConjunct},
+	{0x111CE, 0x111CE, propSpacingMark},
+	{0x111CF, 0x111CF, propExtend | propConjunct},
+	{0x1122C, 0x1122E, propSpacingMark},
+	{0x1122F, 0x11231, propExtend | propConjunct},
+	{0x11232, 0x11233, propSpacingMark},
+	{0x11234, 0x11237, propExtend | propConjunct},
+	{0x1123E, 0x1123E, propExtend | propConjunct},
+	{0x11241, 0x11241, propExtend | propConjunct},
+	{0x112DF, 0x112DF, propExtend | propConjunct},
+	{0x112E0, 0x112E2, propSpacingMark},
+	{0x112E3, 0x112EA, propExtend | propConjunct},
+	{0x11300, 0x11301, propExtend | propConjunct},
+	{0x11302, 0x11303, propSpacingMark},
+	{0x1133B, 0x1133C, propExtend | propConjunct},
+	{0x1133E, 0x1133E, propExtend | propConjunct},
+	{0x1133F, 0x1133F, propSpacingMark},
+	{0x11340, 0x11340, propExtend | propConjunct},
+	{0x11341, 0x11344, propSpacingMark},
+	{0x11347, 0x11348, propSpacingMark},
+	{0x1134B, 0x1134C, propSpacingMark},
+	{0x1134D, 0x1134D, propExtend | propConjunct},
+	{0x11357, 0x11357, propExtend | propConjunct},
+	{0x11362, 0x11363, propSpacingMark},
+	{0x11366, 0x1136C, propExtend | propConjunct},
+	{0x11370, 0x11374, propExtend | propConjunct},
+	{0x11380, 0x11389, propConsonant},
+	{0x1138B, 0x1138B, propConsonant},
+	{0x1138E, 0x1138E, propConsonant},
+	{0x11390, 0x113B5, propConsonant},
+	{0x113B8, 0x113B8, propExtend | propConjunct},
+	{0x113B9, 0x113BA, propSpacingMark},
+	{0x113BB, 0x113C0, propExtend | propConjunct},
+	{0x113C2, 0x113C2, propExtend | propConjunct},
+	{0x113C5, 0x113C5, propExtend | propConjunct},
+	{0x113C7, 0x113C9, propExtend | propConjunct},
+	{0x113CA, 0x113CA, propSpacingMark},
+	{0x113CC, 0x113CD, propSpacingMark},
+	{0x113CE, 0x113CF, propExtend | propConjunct},
+	{0x113D0, 0x113D0, propExtend | propLinker},
+	{0x113D1, 0x113D1, propPrepend},
+	{0x113D2, 0x113D2, propExtend | propConjunct},
+	{0x113E1, 0x113E2, propExtend | propConjunct},
+	{0x11435, 0x11437, propSpacingMark},
+	{0x11438, 0x1143F, propExtend | propConjunct},
+	{0x11440, 0x11441, propSpacingMark},
+	{0x11442, 0x11444, propExtend | propConjunct},
+	{0x11445, 0x11445, propSpacingMark},
+	{0x11446, 0x11446, propExtend | propConjunct},
+	{0x1145E, 0x1145E, propExtend | propConjunct},
+	{0x114B0, 0x114B0, propExtend | propConjunct},
+	{0x114B1, 0x114B2, propSpacingMark},
+	{0x114B3, 0x114B8, propExtend | propConjunct},
+	{0x114B9, 0x114B9, propSpacingMark},
+	{0x114BA, 0x114BA, propExtend | propConjunct},
+	{0x114BB, 0x114BC, propSpacingMark},
+	{0x114BD, 0x114BD, propExtend | propConjunct},
+	{0x114BE, 0x114BE, propSpacingMark},
+	{0x114BF, 0x114C0, propExtend | propConjunct},
+	{0x114C1, 0x114C1, propSpacingMark},
+	{0x114C2, 0x114C3, propExtend | propConjunct},
+	{0x115AF, 0x115AF, propExtend | propConjunct},
+	{0x115B0, 0x115B1, propSpacingMark},
+	{0x115B2, 0x115B5, propExtend | propConjunct},
+	{0x115B8, 0x115BB, propSpacingMark},
+	{0x115BC, 0x115BD, propExtend | propConjunct},
+	{0x115BE, 0x115BE, propSpacingMark},
+	{0x115BF, 0x115C0, propExtend | propConjunct},
+	{0x115DC, 0x115DD, propExtend | propConjunct},
+	{0x11630, 0x11632, propSpacingMark},
+	{0x11633, 0x1163A, propExtend | propConjunct},
+	{0x1163B, 0x1163C, propSpacingMark},
+	{0x1163D, 0x1163D, propExtend | propConjunct},
+	{0x1163E, 0x1163E, propSpacingMark},
+	{0x1163F, 0x11640, propExtend | propConjunct},
+	{0x116AB, 0x116AB, propExtend | propConjunct},
+	{0x116AC, 0x116AC, propSpacingMark},
+	{0x116AD, 0x116AD, propExtend | propConjunct},
+	{0x116AE, 0x116AF, propSpacingMark},
+	{0x116B0, 0x116B7, propExtend | propConjunct},
+	{0x1171D, 0x1171D, propExtend | propConjunct},
+	{0x1171E, 0x1171E, propSpacingMark},
+	{0x1171F, 0x1171F, propExtend | propConjunct},
+	{0x11722, 0x11725, propExtend | propConjunct},
+	{0x11726, 0x11726, propSpacingMark},
+	{0x11727, 0x1172B, propExtend | propConjunct},
+	{0x1182C, 0x1182E, propSpacingMark},
+	{0x1182F, 0x11837, propExtend | propConjunct},
+	{0x11838, 0x11838, propSpacingMark},
+	{0x11839, 0x1183A, propExtend | propConjunct},
+	{0x11900, 0x11906, propConsonant},
+	{0x11909, 0x11909, propConsonant},
+	{0x1190C, 0x11913, propConsonant},
+	{0x11915, 0x11916, propConsonant},
+	{0x11918, 0x1192F, propConsonant},
+	{0x11930, 0x11930, propExtend | propConjunct},
+	{0x11931, 0x11935, propSpacingMark},
+	{0x11937, 0x11938, propSpacingMark},
+	{0x1193B, 0x1193D, propExtend | propConjunct},
+	{0x1193E, 0x1193E, propExtend | propLinker},
+	{0x1193F, 0x1193F, propPrepend},
+	{0x11940, 0x11940, propSpacingMark},
+	{0x11941, 0x11941, propPrepend},
+	{0x11942, 0x11942, propSpacingMark},
+	{0x11943, 0x11943, propExtend | propConjunct},
+	{0x119D1, 0x119D3, propSpacingMark},
+	{0x119D4, 0x119D7, propExtend | propConjunct},
+	{0x119DA, 0x119DB, propExtend | propConjunct},
+	{0x119DC, 0x119DF, propSpacingMark},
+	{0x119E0, 0x119E0, propExtend | propConjunct},
+	{0x119E4, 0x119E4, propSpacingMark},
+	{0x11A00, 0x11A00, propConsonant},
+	{0x11A01, 0x11A0A, propExtend | propConjunct},
+	{0x11A0B, 0x11A32, propConsonant},
+	{0x11A33, 0x11A38, propExtend | propConjunct},
+	{0x11A39, 0x11A39, propSpacingMark},
+	{0x11A3B, 0x11A3E, propExtend | propConjunct},
+	{0x11A47, 0x11A47, propExtend | propLinker},
+	{0x11A50, 0x11A50, propConsonant},
+	{0x11A51, 0x11A56, propExtend | propConjunct},
+	{0x11A57, 0x11A58, propSpacingMark},
+	{0x11A59, 0x11A5B, propExtend | propConjunct},
+	{0x11A5C, 0x11A83, propConsonant},
+	{0x11A84, 0x11A89, propPrepend},
+	{0x11A8A, 0x11A96, propExtend | propConjunct},
+	{0x11A97, 0x11A97, propSpacingMark},
+	{0x11A98, 0x11A98, propExtend | propConjunct},
+	{0x11A99, 0x11A99, propExtend | propLinker},
+	{0x11B60, 0x11B60, propExtend | propConjunct},
+	{0x11B61, 0x11B61, propSpacingMark},
+	{0x11B62, 0x11B64, propExtend | propConjunct},
+	{0x11B65, 0x11B65, propSpacingMark},
+	{0x11B66, 0x11B66, propExtend | propConjunct},
+	{0x11B67, 0x11B67, propSpacingMark},
+	{0x11C2F, 0x11C2F, propSpacingMark},
+	{0x11C30, 0x11C36, propExtend | propConjunct},
+	{0x11C38, 0x11C3D, propExtend | propConjunct},
+	{0x11C3E, 0x11C3E, propSpacingMark},
+	{0x11C3F, 0x11C3F, propExtend | propConjunct},
+	{0x11C92, 0x11CA7, propExtend | propConjunct},
+	{0x11CA9, 0x11CA9, propSpacingMark},
+	{0x11CAA, 0x11CB0, propExtend | propConjunct},
+	{0x11CB1, 0x11CB1, propSpacingMark},
+	{0x11CB2, 0x11CB3, propExtend | propConjunct},
+	{0x11CB4, 0x11CB4, propSpacingMark},
+	{0x11CB5, 0x11CB6, propExtend | propConjunct},
+	{0x11D31, 0x11D36, propExtend | propConjunct},
+	{0x11D3A, 0x11D3A, propExtend | propConjunct},
+	{0x11D3C, 0x11D3D, propExtend | propConjunct},
+	{0x11D3F, 0x11D45, propExtend | propConjunct},
+	{0x11D46, 0x11D46, propPrepend},
+	{0x11D47, 0x11D47, propExtend | propConjunct},
+	{0x11D8A, 0x11D8E, propSpacingMark},
+	{0x11D90, 0x11D91, propExtend | propConjunct},
+	{0x11D93, 0x11D94, propSpacingMark},
+	{0x11D95, 0x11D95, propExtend | propConjunct},
+	{0x11D96, 0x11D96, propSpacingMark},
+	{0x11D97, 0x11D97, propExtend | propConjunct},
+	{0x11EF3, 0x11EF4, propExtend | propConjunct},
+	{0x11EF5, 0x11EF6, propSpacingMark},
+	{0x11F00, 0x11F01, propExtend | propConjunct},
+	{0x11F02, 0x11F02, propPrepend},
+	{0x11F03, 0x11F03, propSpacingMark},
+	{0x11F04, 0x11F10, propConsonant},
+	{0x11F12, 0x11F33, propConsonant},
+	{0x11F34, 0x11F35, propSpacingMark},
+	{0x11F36, 0x11F3A, propExtend | propConjunct},
+	{0x11F3E, 0x11F3F, propSpacingMark},
+	{0x11F40, 0x11F41, propExtend | propConjunct},
+	{0x11F42, 0x11F42, propExtend | propLinker},
+	{0x11F5A, 0x11F5A, propExtend | propConjunct},
+	{0x13430, 0x1343F, propControl},
+	{0x13440, 0x13440, propExtend | propConjunct},
+	{0x13447, 0x13455, propExtend | propConjunct},
+	{0x1611E, 0x16129, propExtend | propConjunct},
+	{0x1612A, 0x1612C, propSpacingMark},
+	{0x1612D, 0x1612F, propExtend | propConjunct},
+	{0x16AF0, 0x16AF4, propExtend | propConjunct},
+	{0x16B30, 0x16B36, propExtend | propConjunct},
+	{0x16D63, 0x16D63, propV},
+	{0x16D67, 0x16D6A, propV},
+	{0x16F4F, 0x16F4F, propExtend | propConjunct},
+	{0x16F51, 0x16F87, propSpacingMark},
+	{0x16F8F, 0x16F92, propExtend | propConjunct},
+	{0x16FE4, 0x16FE4, propExtend | propConjunct},
+	{0x16FF0, 0x16FF1, propExtend | propConjunct},
+	{0x1BC9D, 0x1BC9E, propExtend | propConjunct},
+	{0x1BCA0, 0x1BCA3, propControl},
+	{0x1CF00, 0x1CF2D, propExtend | propConjunct},
+	{0x1CF30, 0x1CF46, propExtend | propConjunct},
+	{0x1D165, 0x1D169, propExtend | propConjunct},
+	{0x1D16D, 0x1D172, propExtend | propConjunct},
+	{0x1D173, 0x1D17A, propControl},
+	{0x1D17B, 0x1D182, propExtend | propConjunct},
+	{0x1D185, 0x1D18B, propExtend | propConjunct},
+	{0x1D1AA, 0x1D1AD, propExtend | propConjunct},
+	{0x1D242, 0x1D244, propExtend | propConjunct},
+	{0x1DA00, 0x1DA36, propExtend | propConjunct},
+	{0x1DA3B, 0x1DA6C, propExtend | propConjunct},
+	{0x1DA75, 0x1DA75, propExtend | propConjunct},
+	{0x1DA84, 0x1DA84, propExtend | propConjunct},
+	{0x1DA9B, 0x1DA9F, propExtend | propConjunct},
+	{0x1DAA1, 0x1DAAF, propExtend | propConjunct},
+	{0x1E000, 0x1E006, propExtend | propConjunct},
+	{0x1E008, 0x1E018, propExtend | propConjunct},
+	{0x1E01B, 0x1E021, propExtend | propConjunct},
+	{0x1E023, 0x1E024, propExtend | propConjunct},
+	{0x1E026, 0x1E02A, propExtend | propConjunct},
+	{0x1E08F, 0x1E08F, propExtend | propConjunct},
+	{0x1E130, 0x1E136, propExtend | propConjunct},
+	{0x1E2AE, 0x1E2AE, propExtend | propConjunct},
+	{0x1E2EC, 0x1E2EF, propExtend | propConjunct},
+	{0x1E4EC, 0x1E4EF, propExtend | propConjunct},
+	{0x1E5EE, 0x1E5EF, propExtend | propConjunct},
+	{0x1E6E3, 0x1E6E3, propExtend | propConjunct},
+	{0x1E6E6, 0x1E6E6, propExtend | propConjunct},
+	{0x1E6EE, 0x1E6EF, propExtend | propConjunct},
+	{0x1E6F5, 0x1E6F5, propExtend | propConjunct},
+	{0x1E8D0, 0x1E8D6, propExtend | propConjunct},
+	{0x1E944, 0x1E94A, propExtend | propConjunct},
+	{0x1F004, 0x1F004, propExtPict},
+	{0x1F02C, 0x1F02F, propExtPict},
+	{0x1F094, 0x1F09F, propExtPict},
+	{0x1F0AF, 0x1F0B0, propExtPict},
+	{0x1F0C0, 0x1F0C0, propExtPict},
+	{0x1F0CF, 0x1F0D0, propExtPict},
+	{0x1F0F6, 0x1F0FF, propExtPict},
+	{0x1F170, 0x1F171, propExtPict},
+	{0x1F17E, 0x1F17F, propExtPict},
+	{0x1F18E, 0x1F18E, propExtPict},
+	{0x1F191, 0x1F19A, propExtPict},
+	{0x1F1AE, 0x1F1E5, propExtPict},
+	{0x1F1E6, 0x1F1FF, propRI},
+	{0x1F201, 0x1F20F, propExtPict},
+	{0x1F21A, 0x1F21A, propExtPict},
+	{0x1F22F, 0x1F22F, propExtPict},
+	{0x1F232, 0x1F23A, propExtPict},
+	{0x1F23C, 0x1F23F, propExtPict},
+	{0x1F249, 0x1F25F, propExtPict},
+	{0x1F266, 0x1F321, propExtPict},
+	{0x1F324, 0x1F393, propExtPict},
+	{0x1F396, 0x1F397, propExtPict},
+	{0x1F399, 0x1F39B, propExtPict},
+	{0x1F39E, 0x1F3F0, propExtPict},
+	{0x1F3F3, 0x1F3F5, propExtPict},
+	{0x1F3F7, 0x1F3FA, propExtPict},
+	{0x1F3FB, 0x1F3FF, propExtend | propConjunct},
+	{0x1F400, 0x1F4FD, propExtPict},
+	{0x1F4FF, 0x1F53D, propExtPict},
+	{0x1F549, 0x1F54E, propExtPict},
+	{0x1F550, 0x1F567, propExtPict},
+	{0x1F56F, 0x1F570, propExtPict},
+	{0x1F573, 0x1F57A, propExtPict},
+	{0x1F587, 0x1F587, propExtPict},
+	{0x1F58A, 0x1F58D, propExtPict},
+	{0x1F590, 0x1F590, propExtPict},
+	{0x1F595, 0x1F596, propExtPict},
+	{0x1F5A4, 0x1F5A5, propExtPict},
+	{0x1F5A8, 0x1F5A8, propExtPict},
+	{0x1F5B1, 0x1F5B2, propExtPict},
+	{0x1F5BC, 0x1F5BC, propExtPict},
+	{0x1F5C2, 0x1F5C4, propExtPict},
+	{0x1F5D1, 0x1F5D3, propExtPict},
+	{0x1F5DC, 0x1F5DE, propExtPict},
+	{0x1F5E1, 0x1F5E1, propExtPict},
+	{0x1F5E3, 0x1F5E3, propExtPict},
+	{0x1F5E8, 0x1F5E8, propExtPict},
+	{0x1F5EF, 0x1F5EF, propExtPict},
+	{0x1F5F3, 0x1F5F3, propExtPict},
+	{0x1F5FA, 0x1F64F, propExtPict},
+	{0x1F680, 0x1F6C5, propExtPict},
+	{0x1F6CB, 0x1F6D2, propExtPict},
+	{0x1F6D5, 0x1F6E5, propExtPict},
+	{0x1F6E9, 0x1F6E9, propExtPict},
+	{0x1F6EB, 0x1F6F0, propExtPict},
+	{0x1F6F3, 0x1F6FF, propExtPict},
+	{0x1F7DA, 0x1F7FF, propExtPict},
+	{0x1F80C, 0x1F80F, propExtPict},
+	{0x1F848, 0x1F84F, propExtPict},
+	{0x1F85A, 0x1F85F, propExtPict},
+	{0x1F888, 0x1F88F, propExtPict},
+	{0x1F8AE, 0x1F8AF, propExtPict},
+	{0x1F8BC, 0x1F8BF, propExtPict},
+	{0x1F8C2, 0x1F8CF, propExtPict},
+	{0x1F8D9, 0x1F8FF, propExtPict},
+	{0x1F90C, 0x1F93A, propExtPict},
+	{0x1F93C, 0x1F945, propExtPict},
+	{0x1F947, 0x1F9FF, propExtPict},
+	{0x1FA58, 0x1FA5F, propExtPict},
+	{0x1FA6E, 0x1FAFF, propExtPict},
+	{0x1FC00, 0x1FFFD, propExtPict},
+	{0xE0000, 0xE001F, propControl},
+	{0xE0020, 0xE007F, propExtend | propConjunct},
+	{0xE0080, 0xE00FF, propControl},
+	{0xE0100, 0xE01EF, propExtend | propConjunct},
+	{0xE01F0, 0xE0FFF, propControl},
+}
+
+// Word_Break and Extended_Pictographic properties
+var wordTable = []propRange{
+	{0x000A, 0x000A, propLF},
+	{0x000B, 0x000C, propNewline},
+	{0x000D, 0x000D, propCR},
+	{0x0020, 0x0020, propWSegSpace},
+	{0x0022, 0x0022, propDoubleQuote},
+	{0x0027, 0x0027, propSingleQuote},
+	{0x002C, 0x002C, propMidNum},
+	{0x002E, 0x002E, propMidNumLet},
+	{0x0030, 0x0039, propNumeric},
+	{0x003A, 0x003A, propMidLetter},
+	{0x003B, 0x003B, propMidNum},
+	{0x0041, 0x005A, propALetter},
+	{0x005F, 0x005F, propExtendNumLet},
+	{0x0061, 0x007A, propALetter},
+	{0x0085, 0x0085, propNewline},
+	{0x00A9, 0x00A9, propExtPict},
+	{0x00AA, 0x00AA, propALetter},
+	{0x00AD, 0x00AD, propFormat},
+	{0x00AE, 0x00AE, propExtPict},
+	{0x00B5, 0x00B5, propALetter},
+	{0x00B7, 0x00B7, propMidLetter},
+	{0x00B8, 0x00B8, propALetter},
+	{0x00BA, 0x00BA, propALetter},
+	{0x00C0, 0x00D6, propALetter},
+	{0x00D8, 0x00F6, propALetter},
+	{0x00F8, 0x02D7, propALetter},
+	{0x02DE, 0x02FF, propALetter},
+	{0x0300, 0x036F, propExtend},
+	{0x0370, 0x0374, propALetter},
+	{0x0376, 0x0377, propALetter},
+	{0x037A, 0x037D, propALetter},
+	{0x037E, 0x037E, propMidNum},
+	{0x037F, 0x037F, propALetter},
+	{0x0386, 0x0386, propALetter},
+	{0x0387, 0x0387, propMidLetter},
+	{0x0388, 0x038A, propALetter},
+	{0x038C, 0x038C, propALetter},
+	{0x038E, 0x03A1, propALetter},
+	{0x03A3, 0x03F5, propALetter},
+	{0x03F7, 0x0481, propALetter},
+	{0x0483, 0x0489, propExtend},
+	{0x048A, 0x052F, propALetter},
+	{0x0531, 0x0556, propALetter},
+	{0x0559, 0x055C, propALetter},
+	{0x055E, 0x055E, propALetter},
+	{0x055F, 0x055F, propMidLetter},
+	{0x0560, 0x0588, propALetter},
+	{0x0589, 0x0589, propMidNum},
+	{0x058A, 0x058A, propALetter},
+	{0x0591, 0x05BD, propExtend},
+	{0x05BF, 0x05BF, propExtend},
+	{0x05C1, 0x05C2, propExtend},
+	{0x05C4, 0x05C5, propExtend},
+	{0x05C7, 0x05C7, propExtend},
+	{0x05D0, 0x05EA, propHebrew},
+	{0x05EF, 0x05F2, propHebrew},
+	{0x05F3, 0x05F3, propALetter},
+	{0x05F4, 0x05F4, propMidLetter},
+	{0x0600, 0x0605, propNumeric},
+	{0x060C, 0x060D, propMidNum},
+	{0x0610, 0x061A, propExtend},
+	{0x061C, 0x061C, propFormat},
+	{0x0620, 0x064A, propALetter},
+	{0x064B, 0x065F, propExtend},
+	{0x0660, 0x0669, propNumeric},
+	{0x066B, 0x066B, propNumeric},
+	{0x066C, 0x066C, propMidNum},
+	{0x066E, 0x066F, propALetter},
+	{0x0670, 0x0670, propExtend},
+	{0x0671, 0x06D3, propALetter},
+	{0x06D5, 0x06D5, propALetter},
+	{0x06D6, 0x06DC, propExtend},
+	{0x06DD, 0x06DD, propNumeric},
+	{0x06DF, 0x06E4, propExtend},
+	{0x06E5, 0x06E6, propALetter},
+	{0x06E7, 0x06E8, propExtend},
+	{0x06EA, 0x06ED, propExtend},
+	{0x06EE, 0x06EF, propALetter},
+	{0x06F0, 0x06F9, propNumeric},
+	{0x06FA, 0x06FC, propALetter},
+	{0x06FF, 0x06FF, propALetter},
+	{0x070F, 0x0710, propALetter},
+	{0x0711, 0x0711, propExtend},
+	{0x0712, 0x072F, propALetter},
+	{0x0730, 0x074A, propExtend},
+	{0x074D, 0x07A5, propALetter},
+	{0x07A6, 0x07B0, propExtend},
+	{0x07B1, 0x07B1, propALetter},
+	{0x07C0, 0x07C9, propNumeric},
+	{0x07CA, 0x07EA, propALetter},
+	{0x07EB, 0x07F3, propExtend},
+	{0x07F4, 0x07F5, propALetter},
+	{0x07F8, 0x07F8, propMidNum},
+	{0x07FA, 0x07FA, propALetter},
+	{0x07FD, 0x07FD, propExtend},
+	{0x0800, 0x0815, propALetter},
+	{0x0816, 0x0819, propExtend},
+	{0x081A, 0x081A, propALetter},
+	{0x081B, 0x0823, propExtend},
+	{0x0824, 0x0824, propALetter},
+	{0x0825, 0x0827, propExtend},
+	{0x0828, 0x0828, propALetter},
+	{0x0829, 0x082D, propExtend},
+	{0x0840, 0x0858, propALetter},
+	{0x0859, 0x085B, propExtend},
+	{0x0860, 0x086A, propALetter},
+	{0x0870, 0x0887, propALetter},
+	{0x0889, 0x088F, propALetter},
+	{0x0890, 0x0891, propNumeric},
+	{0x0897, 0x089F, propExtend},
+	{0x08A0, 0x08C9, propALetter},
+	{0x08CA, 0x08E1, propExtend},
+	{0x08E2, 0x08E2, propNumeric},
+	{0x08E3, 0x0903, propExtend},
+	{0x0904, 0x0939, propALetter},
+	{0x093A, 0x093C, propExtend},
+	{0x093D, 0x093D, propALetter},
+	{0x093E, 0x094F, propExtend},
+	{0x0950, 0x0950, propALetter},
+	{0x0951, 0x0957, propExtend},
+	{0x0958, 0x0961, propALetter},
+	{0x0962, 0x0963, propExtend},
+	{0x0966, 0x096F, propNumeric},
+	{0x0971, 0x0980, propALetter},
+	{0x0981, 0x0983, propExtend},
+	{0x0985, 0x098C, propALetter},
+	{0x098F, 0x0990, propALetter},
+	{0x0993, 0x09A8, propALetter},
+	{0x09AA, 0x09B0, propALetter},
+	{0x09B2, 0x09B2, propALetter},
+	{0x09B6, 0x09B9, propALetter},
+	{0x09BC, 0x09BC, propExtend},
+	{0x09BD, 0x09BD, propALetter},
+	{0x09BE, 0x09C4, propExtend},
+	{0x09C7, 0x09C8, propExtend},
+	{0x09CB, 0x09CD, propExtend},
+	{0x09CE, 0x09CE, propALetter},
+	{0x09D7, 0x09D7, propExtend},
+	{0x09DC, 0x09DD, propALetter},
+	{0x09DF, 0x09E1, propALetter},
+	{0x09E2, 0x09E3, propExtend},
+	{0x09E6, 0x09EF, propNumeric},
+	{0x09F0, 0x09F1, propALetter},
+	{0x09FC, 0x09FC, propALetter},
+	{0x09FE, 0x09FE, propExtend},
+	{0x0A01, 0x0A03, propExtend},
+	{0x0A05, 0x0A0A, propALetter},
+	{0x0A0F, 0x0A10, propALetter},
+	{0x0A13, 0x0A28, propALetter},
+	{0x0A2A, 0x0A30, propALetter},
+	{0x0A32, 0x0A33, propALetter},
+	{0x0A35, 0x0A36, propALetter},
+	{0x0A38, 0x0A39, propALetter},
+	{0x0A3C, 0x0A3C, propExtend},
+	{0x0A3E, 0x0A42, propExtend},
+	{0x0A47, 0x0A48, propExtend},
+	{0x0A4B, 0x0A4D, propExtend},
+	{0x0A51, 0x0A51, propExtend},
+	{0x0A59, 0x0A5C, propALetter},
+	{0x0A5E, 0x0A5E, propALetter},
+	{0x0A66, 0x0A6F, propNumeric},
+	{0x0A70, 0x0A71, propExtend},
+	{0x0A72, 0x0A74, propALetter},
+	{0x0A75, 0x0A75, propExtend},
+	{0x0A81, 0x0A83, propExtend},
+	{0x0A85, 0x0A8D, propALetter},
+	{0x0A8F, 0x0A91, propALetter},
+	{0x0A93, 0x0AA8, propALetter},
+	{0x0AAA, 0x0AB0, propALetter},
+	{0x0AB2, 0x0AB3, propALetter},
+	{0x0AB5, 0x0AB9, propALetter},
+	{0x0ABC, 0x0ABC, propExtend},
+	{0x0ABD, 0x0ABD, propALetter},
+	{0x0ABE, 0x0AC5, propExtend},
+	{0x0AC7, 0x0AC9, propExtend},
+	{0x0ACB, 0x0ACD, propExtend},
+	{0x0AD0, 0x0AD0, propALetter},
+	{0x0AE0, 0x0AE1, propALetter},
+	{0x0AE2, 0x0AE3, propExtend},
+	{0x0AE6, 0x0AEF, propNumeric},
+	{0x0AF9, 0x0AF9, propALetter},
+	{0x0AFA, 0x0AFF, propExtend},
+	{0x0B01, 0x0B03, propExtend},
+	{0x0B05, 0x0B0C, propALetter},
+	{0x0B0F, 0x0B10, propALetter},
+	{0x0B13, 0x0B28, propALetter},
+	{0x0B2A, 0x0B30, propALetter},
+	{0x0B32, 0x0B33, propALetter},
+	{0x0B35, 0x0B39, propALetter},
+	{0x0B3C, 0x0B3C, propExtend},
+	{0x0B3D, 0x0B3D, propALetter},
+	{0x0B3E, 0x0B44, propExtend},
+	{0x0B47, 0x0B48, propExtend},
+	{0x0B4B, 0x0B4D, propExtend},
+	{0x0B55, 0x0B57, propExtend},
+	{0x0B5C, 0x0B5D, propALetter},
+	{0x0B5F, 0x0B61, propALetter},
+	{0x0B62, 0x0B63, propExtend},
+	{0x0B66, 0x0B6F, propNumeric},
+	{0x0B71, 0x0B71, propALetter},
+	{0x0B82, 0x0B82, propExtend},
+	{0x0B83, 0x0B83, propALetter},
+	{0x0B85, 0x0B8A, propALetter},
+	{0x0B8E, 0x0B90, propALetter},
+	{0x0B92, 0x0B95, propALetter},
+	{0x0B99, 0x0B9A, propALetter},
+	{0x0B9C, 0x0B9C, propALetter},
+	{0x0B9E, 0x0B9F, propALetter},
+	{0x0BA3, 0x0BA4, propALetter},
+	{0x0BA8, 0x0BAA, propALetter},
+	{0x0BAE, 0x0BB9, propALetter},
+	{0x0BBE, 0x0BC2, propExtend},
+	{0x0BC6, 0x0BC8, propExtend},
+	{0x0BCA, 0x0BCD, propExtend},
+	{0x0BD0, 0x0BD0, propALetter},
+	{0x0BD7, 0x0BD7, propExtend},
+	{0x0BE6, 0x0BEF, propNumeric},
+	{0x0C00, 0x0C04, propExtend},
+	{0x0C05, 0x0C0C, propALetter},
+	{0x0C0E, 0x0C10, propALetter},
+	{0x0C12, 0x0C28, propALetter},
+	{0x0C2A, 0x0C39, propALetter},
+	{0x0C3C, 0x0C3C, propExtend},
+	{0x0C3D, 0x0C3D, propALetter},
+	{0x0C3E, 0x0C44, propExtend},
+	{0x0C46, 0x0C48, propExtend},
+	{0x0C4A, 0x0C4D, propExtend},
+	{0x0C55, 0x0C56, propExtend},
+	{0x0C58, 0x0C5A, propALetter},
+	{0x0C5C, 0x0C5D, propALetter},
+	{0x0C60, 0x0C61, propALetter},
+	{0x0C62, 0x0C63, propExtend},
+	{0x0C66, 0x0C6F, propNumeric},
+	{0x0C80, 0x0C80, propALetter},
+	{0x0C81, 0x0C83, propExtend},
+	{0x0C85, 0x0C8C, propALetter},
+	{0x0C8E, 0x0C90, propALetter},
+	{0x0C92, 0x0CA8, propALetter},
+	{0x0CAA, 0x0CB3, propALetter},
+	{0x0CB5, 0x0CB9, propALetter},
+	{0x0CBC, 0x0CBC, propExtend},
+	{0x0CBD, 0x0CBD, propALetter},
+	{0x0CBE, 0x0CC4, propExtend},
+	{0x0CC6, 0x0CC8, propExtend},
+	{0x0CCA, 0x0CCD, propExtend},
+	{0x0CD5, 0x0CD6, propExtend},
+	{0x0CDC, 0x0CDE, propALetter},
+	{0x0CE0, 0x0CE1, propALetter},
+	{0x0CE2, 0x0CE3, propExtend},
+	{0x0CE6, 0x0CEF, propNumeric},
+	{0x0CF1, 0x0CF2, propALetter},
+	{0x0CF3, 0x0CF3, propExtend},
+	{0x0D00, 0x0D03, propExtend},
+	{0x0D04, 0x0D0C, propALetter},
+	{0x0D0E, 0x0D10, propALetter},
+	{0x0D12, 0x0D3A, propALetter},
+	{0x0D3B, 0x0D3C, propExtend},
+	{0x0D3D, 0x0D3D, propALetter},
+	{0x0D3E, 0x0D44, propExtend},
+	{0x0D46, 0x0D48, propExtend},
+	{0x0D4A, 0x0D4D, propExtend},
+	{0x0D4E, 0x0D4E, propALetter},
+	{0x0D54, 0x0D56, propALetter},
+	{0x0D57, 0x0D57, propExtend},
+	{0x0D5F, 0x0D61, propALetter},
+	{0x0D62, 0x0D63, propExtend},
+	{0x0D66, 0x0D6F, propNumeric},
+	{0x0D7A, 0x0D7F, propALetter},
+	{0x0D81, 0x0D83, propExtend},
+	{0x0D85, 0x0D96, propALetter},
+	{0x0D9A, 0x0DB1, propALetter},
+	{0x0DB3, 0x0DBB, propALetter},
+	{0x0DBD, 0x0DBD, propALetter},
+	{0x0DC0, 0x0DC6, propALetter},
+	{0x0DCA, 0x0DCA, propExtend},
+	{0x0DCF, 0x0DD4, propExtend},
+	{0x0DD6, 0x0DD6, propExtend},
+	{0x0DD8, 0x0DDF, propExtend},
+	{0x0DE6, 0x0DEF, propNumeric},
+	{0x0DF2, 0x0DF3, propExtend},
+	{0x0E31, 0x0E31, propExtend},
+	{0x0E34, 0x0E3A, propExtend},
+	{0x0E47, 0x0E4E, propExtend},
+	{0x0E50, 0x0E59, propNumeric},
+	{0x0EB1, 0x0EB1, propExtend},
+	{0x0EB4, 0x0EBC, propExtend},
+	{0x0EC8, 0x0ECE, propExtend},
+	{0x0ED0, 0x0ED9, propNumeric},
+	{0x0F00, 0x0F00, propALetter},
+	{0x0F18, 0x0F19, propExtend},
+	{0x0F20, 0x0F29, propNumeric},
+	{0x0F35, 0x0F35, propExtend},
+	{0x0F37, 0x0F37, propExtend},
+	{0x0F39, 0x0F39, propExtend},
+	{0x0F3E, 0x0F3F, propExtend},
+	{0x0F40, 0x0F47, propALetter},
+	{0x0F49, 0x0F6C, propALetter},
+	{0x0F71, 0x0F84, propExtend},
+	{0x0F86, 0x0F87, propExtend},
+	{0x0F88, 0x0F8C, propALetter},
+	{0x0F8D, 0x0F97, propExtend},
+	{0x0F99, 0x0FBC, propExtend},
+	{0x0FC6, 0x0FC6, propExtend},
+	{0x102B, 0x103E, propExtend},
+	{0x1040, 0x1049, propNumeric},
+	{0x1056, 0x1059, propExtend},
+	{0x105E, 0x1060, propExtend},
+	{0x1062, 0x1064, propExtend},
+	{0x1067, 0x106D, propExtend},
+	{0x1071, 0x1074, propExtend},
+	{0x1082, 0x108D, propExtend},
+	{0x108F, 0x108F, propExtend},
+	{0x1090, 0x1099, propNumeric},
+	{0x109A, 0x109D, propExtend},
+	{0x10A0, 0x10C5, propALetter},
+	{0x10C7, 0x10C7, propALetter},
+	{0x10CD, 0x10CD, propALetter},
+	{0x10D0, 0x10FA, propALetter},
+	{0x10FC, 0x1248, propALetter},
+	{0x124A, 0x124D, propALetter},
+	{0x1250, 0x1256, propALetter},
+	{0x1258, 0x1258, propALetter},
+	{0x125A, 0x125D, propALetter},
+	{0x1260, 0x1288, propALetter},
+	{0x128A, 0x128D, propALetter},
+	{0x1290, 0x12B0, propALetter},
+	{0x12B2, 0x12B5, propALetter},
+	{0x12B8, 0x12BE, propALetter},
+	{0x12C0, 0x12C0, propALetter},
+	{0x12C2, 0x12C5, propALetter},
+	{0x12C8, 0x12D6, propALetter},
+	{0x12D8, 0x1310, propALetter},
+	{0x1312, 0x1315, propALetter},
+	{0x1318, 0x135A, propALetter},
+	{0x135D, 0x135F, propExtend},
+	{0x1380, 0x138F, propALetter},
+	{0x13A0, 0x13F5, propALetter},
+	{0x13F8, 0x13FD, propALetter},
+	{0x1401, 0x166C, propALetter},
+	{0x166F, 0x167F, propALetter},
+	{0x1680, 0x1680, propWSegSpace},
+	{0x1681, 0x169A, propALetter},
+	{0x16A0, 0x16EA, propALetter},
+	{0x16EE, 0x16F8, propALetter},
+	{0x1700, 0x1711, propALetter},
+	{0x1712, 0x1715, propExtend},
+	{0x171F, 0x1731, propALetter},
+	{0x1732, 0x1734, propExtend},
+	{0x1740, 0x1751, propALetter},
+	{0x1752, 0x1753, propExtend},
+	{0x1760, 0x176C, propALetter},
+	{0x176E, 0x1770, propALetter},
+	{0x1772, 0x1773, propExtend},
+	{0x17B4, 0x17D3, propExtend},
+	{0x17DD, 0x17DD, propExtend},
+	{0x17E0, 0x17E9, propNumeric},
+	{0x180B, 0x180D, propExtend},
+	{0x180E, 0x180E, propFormat},
+	{0x180F, 0x180F, propExtend},
+	{0x1810, 0x1819, propNumeric},
+	{0x1820, 0x1878, propALetter},
+	{0x1880, 0x1884, propALetter},
+	{0x1885, 0x1886, propExtend},
+	{0x1887, 0x18A8, propALetter},
+	{0x18A9, 0x18A9, propExtend},
+	{0x18AA, 0x18AA, propALetter},
+	{0x18B0, 0x18F5, propALetter},
+	{0x1900, 0x191E, propALetter},
+	{0x1920, 0x192B, propExtend},
+	{0x1930, 0x193B, propExtend},
+	{0x1946, 0x194F, propNumeric},
+	{0x19D0, 0x19DA, propNumeric},
+	{0x1A00, 0x1A16, propALetter},
+	{0x1A17, 0x1A1B, propExtend},
+	{0x1A55, 0x1A5E, propExtend},
+	{0x1A60, 0x1A7C, propExtend},
+	{0x1A7F, 0x1A7F, propExtend},
+	{0x1A80, 0x1A89, propNumeric},
+	{0x1A90, 0x1A99, propNumeric},
+	{0x1AB0, 0x1ADD, propExtend},
+	{0x1AE0, 0x1AEB, propExtend},
+	{0x1B00, 0x1B04, propExtend},
+	{0x1B05, 0x1B33, propALetter},
+	{0x1B34, 0x1B44, propExtend},
+	{0x1B45, 0x1B4C, propALetter},
+	{0x1B50, 0x1B59, propNumeric},
+	{0x1B6B, 0x1B73, propExtend},
+	{0x1B80, 0x1B82, propExtend},
+	{0x1B83, 0x1BA0, propALetter},
+	{0x1BA1, 0x1BAD, propExtend},
+	{0x1BAE, 0x1BAF, propALetter},
+	{0x1BB0, 0x1BB9, propNumeric},
+	{0x1BBA, 0x1BE5, propALetter},
+	{0x1BE6, 0x1BF3, propExtend},
+	{0x1C00, 0x1C23, propALetter},
+	{0x1C24, 0x1C37, propExtend},
+	{0x1C40, 0x1C49, propNumeric},
+	{0x1C4D, 0x1C4F, propALetter},
+	{0x1C50, 0x1C59, propNumeric},
+	{0x1C5A, 0x1C7D, propALetter},
+	{0x1C80, 0x1C8A, propALetter},
+	{0x1C90, 0x1CBA, propALetter},
+	{0x1CBD, 0x1CBF, propALetter},
+	{0x1CD0, 0x1CD2, propExtend},
+	{0x1CD4, 0x1CE8, propExtend},
+	{0x1CE9, 0x1CEC, propALetter},
+	{0x1CED, 0x1CED, propExtend},
+	{0x1CEE, 0x1CF3, propALetter},
+	{0x1CF4, 0x1CF4, propExtend},
+	{0x1CF5, 0x1CF6, propALetter},
+	{0x1CF7, 0x1CF9, propExtend},
+	{0x1CFA, 0x1CFA, propALetter},
+	{0x1D00, 0x1DBF, propALetter},
+	{0x1DC0, 0x1DFF, propExtend},
+	{0x1E00, 0x1F15, propALetter},
+	{0x1F18, 0x1F1D, propALetter},
+	{0x1F20, 0x1F45, propALetter},
+	{0x1F48, 0x1F4D, propALetter},
+	{0x1F50, 0x1F57, propALetter},
+	{0x1F59, 0x1F59, propALetter},
+	{0x1F5B, 0x1F5B, propALetter},
+	{0x1F5D, 0x1F5D, propALetter},
+	{0x1F5F, 0x1F7D, propALetter},
+	{0x1F80, 0x1FB4, propALetter},
+	{0x1FB6, 0x1FBC, propALetter},
+	{0x1FBE, 0x1FBE, propALetter},
+	{0x1FC2, 0x1FC4, propALetter},
+	{0x1FC6, 0x1FCC, propALetter},
+	{0x1FD0, 0x1FD3, propALetter},
+	{0x1FD6, 0x1FDB, propALetter},
+	{0x1FE0, 0x1FEC, propALetter},
+	{0x1FF2, 0x1FF4, propALetter},
+	{0x1FF6, 0x1FFC, propALetter},
+	{0x2000, 0x2006, propWSegSpace},
+	{0x2008, 0x200A, propWSegSpace},
+	{0x200C, 0x200C, propExtend},
+	{0x200D, 0x200D, propZWJ},
+	{0x200E, 0x200F, propFormat},
+	{0x2018, 0x2019, propMidNumLet},
+	{0x2024, 0x2024, propMidNumLet},
+	{0x2027, 0x2027, propMidLetter},
+	{0x2028, 0x2029, propNewline},
+	{0x202A, 0x202E, propFormat},
+	{0x202F, 0x202F, propExtendNumLet},
+	{0x203C, 0x203C, propExtPict},
+	{0x203F, 0x2040, propExtendNumLet},
+	{0x2044, 0x2044, propMidNum},
+	{0x2049, 0x2049, propExtPict},
+	{0x2054, 0x2054, propExtendNumLet},
+	{0x205F, 0x205F, propWSegSpace},
+	{0x2060, 0x2064, propFormat},
+	{0x2066, 0x206F, propFormat},
+	{0x2071, 0x2071, propALetter},
+	{0x207F, 0x207F, propALetter},
+	{0x2090, 0x209C, propALetter},
+	{0x20D0, 0x20F0, propExtend},
+	{0x2102, 0x2102, propALetter},
+	{0x2107, 0x2107, propALetter},
+	{0x210A, 0x2113, propALetter},
+	{0x2115, 0x2115, propALetter},
+	{0x2119, 0x211D, propALetter},
+	{0x2122, 0x2122, propExtPict},
+	{0x2124, 0x2124, propALetter},
+	{0x2126, 0x2126, propALetter},
+	{0x2128, 0x2128, propALetter},
+	{0x212A, 0x212D, propALetter},
+	{0x212F, 0x2138, propALetter},
+	{0x2139, 0x2139, propALetter | propExtPict},
+	{0x213C, 0x213F, propALetter},
+	{0x2145, 0x2149, propALetter},
+	{0x214E, 0x214E, propALetter},
+	{0x2160, 0x2188, propALetter},
+	{0x2194, 0x2199, propExtPict},
+	{0x21A9, 0x21AA, propExtPict},
+	{0x231A, 0x231B, propExtPict},
+	{0x2328, 0x2328, propExtPict},
+	{0x23CF, 0x23CF, propExtPict},
+	{0x23E9, 0x23F3, propExtPict},
+	{0x23F8, 0x23FA, propExtPict},
+	{0x24B6, 0x24C1, propALetter},
+	{0x24C2, 0x24C2, propALetter | propExtPict},
+	{0x24C3, 0x24E9, propALetter},
+	{0x25AA, 0x25AB, propExtPict},
+	{0x25B6, 0x25B6, propExtPict},
+	{0x25C0, 0x25C0, propExtPict},
+	{0x25FB, 0x25FE, propExtPict},
+	{0x2600, 0x2604, propExtPict},
+	{0x260E, 0x260E, propExtPict},
+	{0x2611, 0x2611, propExtPict},
+	{0x2614, 0x2615, propExtPict},
+	{0x2618, 0x2618, propExtPict},
+	{0x261D, 0x261D, propExtPict},
+	{0x2620, 0x2620, propExtPict},
+	{0x2622, 0x2623, propExtPict},
+	{0x2626, 0x2626, propExtPict},
+	{0x262A, 0x262A, propExtPict},
+	{0x262E, 0x262F, propExtPict},
+	{0x2638, 0x263A, propExtPict},
+	{0x2640, 0x2640, propExtPict},
+	{0x2642, 0x2642, propExtPict},
+	{0x2648, 0x2653, propExtPict},
+	{0x265F, 0x2660, propExtPict},
+	{0x2663, 0x2663, propExtPict},
+	{0x2665, 0x2666, propExtPict},
+	{0x2668, 0x2668, propExtPict},
+	{0x267B, 0x267B, propExtPict},
+	{0x267E, 0x267F, propExtPict},
+	{0x2692, 0x2697, propExtPict},
+	{0x2699, 0x2699, propExtPict},
+	{0x269B, 0x269C, propExtPict},
+	{0x26A0, 0x26A1, propExtPict},
+	{0x26A7, 0x26A7, propExtPict},
+	{0x26AA, 0x26AB, propExtPict},
+	{0x26B0, 0x26B1, propExtPict},
+	{0x26BD, 0x26BE, propExtPict},
+	{0x26C4, 0x26C5, propExtPict},
+	{0x26C8, 0x26C8, propExtPict},
+	{0x26CE, 0x26CF, propExtPict},
+	{0x26D1, 0x26D1, propExtPict},
+	{0x26D3, 0x26D4, propExtPict},
+	{0x26E9, 0x26EA, propExtPict},
+	{0x26F0, 0x26F5, propExtPict},
+	{0x26F7, 0x26FA, propExtPict},
+	{0x26FD, 0x26FD, propExtPict},
+	{0x2702, 0x2702, propExtPict},
+	{0x2705, 0x2705, propExtPict},
+	{0x2708, 0x270D, propExtPict},
+	{0x270F, 0x270F, propExtPict},
+	{0x2712, 0x2712, propExtPict},
+	{0x2714, 0x2714, propExtPict},
+	{0x2716, 0x2716, propExtPict},
+	{0x271D, 0x271D, propExtPict},
+	{0x2721, 0x2721, propExtPict},
+	{0x2728, 0x2728, propExtPict},
+	{0x2733, 0x2734, propExtPict},
+	{0x2744, 0x2744, propExtPict},
+	{0x2747, 0x2747, propExtPict},
+	{0x274C, 0x274C, propExtPict},
+	{0x274E, 0x274E, propExtPict},
+	{0x2753, 0x2755, propExtPict},
+	{0x2757, 0x2757, propExtPict},
+	{0x2763, 0x2764, propExtPict},
+	{0x2795, 0x2797, propExtPict},
+	{0x27A1, 0x27A1, propExtPict},
+	{0x27B0, 0x27B0, propExtPict},
+	{0x27BF, 0x27BF, propExtPict},
+	{0x2934, 0x2935, propExtPict},
+	{0x2B05, 0x2B07, propExtPict},
+	{0x2B1B, 0x2B1C, propExtPict},
+	{0x2B50, 0x2B50, propExtPict},
+	{0x2B55, 0x2B55, propExtPict},
+	{0x2C00, 0x2CE4, propALetter},
+	{0x2CEB, 0x2CEE, propALetter},
+	{0x2CEF, 0x2CF1, propExtend},
+	{0x2CF2, 0x2CF3, propALetter},
+	{0x2D00, 0x2D25, propALetter},
+	{0x2D27, 0x2D27, propALetter},
+	{0x2D2D, 0x2D2D, propALetter},
+	{0x2D30, 0x2D67, propALetter},
+	{0x2D6F, 0x2D6F, propALetter},
+	{0x2D7F, 0x2D7F, propExtend},
+	{0x2D80, 0x2D96, propALetter},
+	{0x2DA0, 0x2DA6, propALetter},
+	{0x2DA8, 0x2DAE, propALetter},
+	{0x2DB0, 0x2DB6, propALetter},
+	{0x2DB8, 0x2DBE, propALetter},
+	{0x2DC0, 0x2DC6, propALetter},
+	{0x2DC8, 0x2DCE, propALetter},
+	{0x2DD0, 0x2DD6, propALetter},
+	{0x2DD8, 0x2DDE, propALetter},
+	{0x2DE0, 0x2DFF, propExtend},
+	{0x2E2F, 0x2E2F, propALetter},
+	{0x3000, 0x3000, propWSegSpace},
+	{0x3005, 0x3005, propALetter},
+	{0x302A, 0x302F, propExtend},
+	{0x3030, 0x3030, propExtPict},
+	{0x3031, 0x3035, propKatakana},
+	{0x303B, 0x303C, propALetter},
+	{0x303D, 0x303D, propExtPict},
+	{0x3099, 0x309A, propExtend},
+	{0x309B, 0x309C, propKatakana},
+	{0x30A0, 0x30FA, propKatakana},
+	{0x30FC, 0x30FF, propKatakana},
+	{0x3105, 0x312F, propALetter},
+	{0x3131, 0x318E, propALetter},
+	{0x31A0, 0x31BF, propALetter},
+	{0x31F0, 0x31FF, propKatakana},
+	{0x3297, 0x3297, propExtPict},
+	{0x3299, 0x3299, propExtPict},
+	{0x32D0, 0x32FE, propKatakana},
+	{0x3300, 0x3357, propKatakana},
+	{0xA000, 0xA48C, propALetter},
+	{0xA4D0, 0xA4FD, propALetter},
+	{0xA500, 0xA60C, propALetter},
+	{0xA610, 0xA61F, propALetter},
+	{0xA620, 0xA629, propNumeric},
+	{0xA62A, 0xA62B, propALetter},
+	{0xA640, 0xA66E, propALetter},
+	{0xA66F, 0xA672, propExtend},
+	{0xA674, 0xA67D, propExtend},
+	{0xA67F, 0xA69D, propALetter},
+	{0xA69E, 0xA69F, propExtend},
+	{0xA6A0, 0xA6EF, propALetter},
+	{0xA6F0, 0xA6F1, propExtend},
+	{0xA708, 0xA7DC, propALetter},
+	{0xA7F1, 0xA801, propALetter},
+	{0xA802, 0xA802, propExtend},
+	{0xA803, 0xA805, propALetter},
+	{0xA806, 0xA806, propExtend},
+	{0xA807, 0xA80A, propALetter},
+	{0xA80B, 0xA80B, propExtend},
+	{0xA80C, 0xA822, propALetter},
+	{0xA823, 0xA827, propExtend},
+	{0xA82C, 0xA82C, propExtend},
+	{0xA840, 0xA873, propALetter},
+	{0xA880, 0xA881, propExtend},
+	{0xA882, 0xA8B3, propALetter},
+	{0xA8B4, 0xA8C5, propExtend},
+	{0xA8D0, 0xA8D9, propNumeric},
+	{0xA8E0, 0xA8F1, propExtend},
+	{0xA8F2, 0xA8F7, propALetter},
+	{0xA8FB, 0xA8FB, propALetter},
+	{0xA8FD, 0xA8FE, propALetter},
+	{0xA8FF, 0xA8FF, propExtend},
+	{0xA900, 0xA909, propNumeric},
+	{0xA90A, 0xA925, propALetter},
+	{0xA926, 0xA92D, propExtend},
+	{0xA930, 0xA946, propALetter},
+	{0xA947, 0xA953, propExtend},
+	{0xA960, 0xA97C, propALetter},
+	{0xA980, 0xA983, propExtend},
+	{0xA984, 0xA9B2, propALetter},
+	{0xA9B3, 0xA9C0, propExtend},
+	{0xA9CF, 0xA9CF, propALetter},
+	{0xA9D0, 0xA9D9, propNumeric},
+	{0xA9E5, 0xA9E5, propExtend},
+	{0xA9F0, 0xA9F9, propNumeric},
+	{0xAA00, 0xAA28, propALetter},
+	{0xAA29, 0xAA36, propExtend},
+	{0xAA40, 0xAA42, propALetter},
+	{0xAA43, 0xAA43, propExtend},
+	{0xAA44, 0xAA4B, propALetter},
+	{0xAA4C, 0xAA4D, propExtend},
+	{0xAA50, 0xAA59, propNumeric},
+	{0xAA7B, 0xAA7D, propExtend},
+	{0xAAB0, 0xAAB0, propExtend},
+	{0xAAB2, 0xAAB4, propExtend},
+	{0xAAB7, 0xAAB8, propExtend},
+	{0xAABE, 0xAABF, propExtend},
+	{0xAAC1, 0xAAC1, propExtend},
+	{0xAAE0, 0xAAEA, propALetter},
+	{0xAAEB, 0xAAEF, propExtend},
+	{0xAAF2, 0xAAF4, propALetter},
+	{0xAAF5, 0xAAF6, propExtend},
+	{0xAB01, 0xAB06, propALetter},
+	{0xAB09, 0xAB0E, propALetter},
+	{0xAB11, 0xAB16, propALetter},
+	{0xAB20, 0xAB26, propALetter},
+	{0xAB28, 0xAB2E, propALetter},
+	{0xAB30, 0xAB69, propALetter},
+	{0xAB70, 0xABE2, propALetter},
+	{0xABE3, 0xABEA, propExtend},
+	{0xABEC, 0xABED, propExtend},
+	{0xABF0, 0xABF9, propNumeric},
+	{0xAC00, 0xD7A3, propALetter},
+	{0xD7B0, 0xD7C6, propALetter},
+	{0xD7CB, 0xD7FB, propALetter},
+	{0xFB00, 0xFB06, propALetter},
+	{0xFB13, 0xFB17, propALetter},
+	{0xFB1D, 0xFB1D, propHebrew},
+	{0xFB1E, 0xFB1E, propExtend},
+	{0xFB1F, 0xFB28, propHebrew},
+	{0xFB2A, 0xFB36, propHebrew},
+	{0xFB38, 0xFB3C, propHebrew},
+	{0xFB3E, 0xFB3E, propHebrew},
+	{0xFB40, 0xFB41, propHebrew},
+	{0xFB43, 0xFB44, propHebrew},
+	{0xFB46, 0xFB4F, propHebrew},
+	{0xFB50, 0xFBB1, propALetter},
+	{0xFBD3, 0xFD3D, propALetter},
+	{0xFD50, 0xFD8F, propALetter},
+	{0xFD92, 0xFDC7, propALetter},
+	{0xFDF0, 0xFDFB, propALetter},
+	{0xFE00, 0xFE0F, propExtend},
+	{0xFE13, 0xFE13, propMidLetter},
+	{0xFE20, 0xFE2F, propExtend},
+	{0xFE33, 0xFE34, propExtendNumLet},
+	{0xFE4D, 0xFE4F, propExtendNumLet},
+	{0xFE50, 0xFE50, propMidNum},
+	{0xFE52, 0xFE52, propMidNumLet},
+	{0xFE54, 0xFE54, propMidNum},
+	{0xFE55, 0xFE55, propMidLetter},
+	{0xFE70, 0xFE74, propALetter},
+	{0xFE76, 0xFEFC, propALetter},
+	{0xFEFF, 0xFEFF, propFormat},
+	{0xFF07, 0xFF07, propMidNumLet},
+	{0xFF0C, 0xFF0C, propMidNum},
+	{0xFF0E, 0xFF0E, propMidNumLet},
+	{0xFF10, 0xFF19, propNumeric},
+	{0xFF1A, 0xFF1A, propMidLetter},
+	{0xFF1B, 0xFF1B, propMidNum},
+	{0xFF21, 0xFF3A, propALetter},
+	{0xFF3F, 0xFF3F, propExtendNumLet},
+	{0xFF41, 0xFF5A, propALetter},
+	{0xFF66, 0xFF9D, propKatakana},
+	{0xFF9E, 0xFF9F, propExtend},
+	{0xFFA0, 0xFFBE, propALetter},
+	{0xFFC2, 0xFFC7, propALetter},
+	{0xFFCA, 0xFFCF, propALetter},
+	{0xFFD2, 0xFFD7, propALetter},
+	{0xFFDA, 0xFFDC, propALetter},
+	{0xFFF9, 0xFFFB, propFormat},
+	{0x10000, 0x1000B, propALetter},
+	{0x1000D, 0x10026, propALetter},
+	{0x10028, 0x1003A, propALetter},
+	{0x1003C, 0x1003D, propALetter},
+	{0x1003F, 0x1004D, propALetter},
+	{0x10050, 0x1005D, propALetter},
+	{0x10080, 0x100FA, propALetter},
+	{0x10140, 0x10174, propALetter},
+	{0x101FD, 0x101FD, propExtend},
+	{0x10280, 0x1029C, propALetter},
+	{0x102A0, 0x102D0, propALetter},
+	{0x102E0, 0x102E0, propExtend},
+	{0x10300, 0x1031F, propALetter},
+	{0x1032D, 0x1034A, propALetter},
+	{0x10350, 0x10375, propALetter},
+	{0x10376, 0x1037A, propExtend},
+	{0x10380, 0x1039D, propALetter},
+	{0x103A0, 0x103C3, propALetter},
+	{0x103C8, 0x103CF, propALetter},
+	{0x103D1, 0x103D5, propALetter},
+	{0x10400, 0x1049D, propALetter},
+	{0x104A0, 0x104A9, propNumeric},
+	{0x104B0, 0x104D3, propALetter},
+	{0x104D8, 0x104FB, propALetter},
+	{0x10500, 0x10527, propALetter},
+	{0x10530, 0x10563, propALetter},
+	{0x10570, 0x1057A, propALetter},
+	{0x1057C, 0x1058A, propALetter},
+	{0x1058C, 0x10592, propALetter},
+	{0x10594, 0x10595, propALetter},
+	{0x10597, 0x105A1, propALetter},
+	{0x105A3, 0x105B1, propALetter},
+	{0x105B3, 0x105B9, propALetter},
+	{0x105BB, 0x105BC, propALetter},
+	{0x105C0, 0x105F3, propALetter},
+	{0x10600, 0x10736, propALetter},
+	{0x10740, 0x10755, propALetter},
+	{0x10760, 0x10767, propALetter},
+	{0x10780, 0x10785, propALetter},
+	{0x10787, 0x107B0, propALetter},
+	{0x107B2, 0x107BA, propALetter},
+	{0x10800, 0x10805, propALetter},
+	{0x10808, 0x10808, propALetter},
+	{0x1080A, 0x10835, propALetter},
+	{0x10837, 0x10838, propALetter},
+	{0x1083C, 0x1083C, propALetter},
+	{0x1083F, 0x10855, propALetter},
+	{0x10860, 0x10876, propALetter},
+	{0x10880, 0x1089E, propALetter},
+	{0x108E0, 0x108F2, propALetter},
+	{0x108F4, 0x108F5, propALetter},
+	{0x10900, 0x10915, propALetter},
+	{0x10920, 0x10939, propALetter},
+	{0x10940, 0x10959, propALetter},
+	{0x10980, 0x109B7, propALetter},
+	{0x109BE, 0x109BF, propALetter},
+	{0x10A00, 0x10A00, propALetter},
+	{0x10A01, 0x10A03, propExtend},
+	{0x10A05, 0x10A06, propExtend},
+	{0x10A0C, 0x10A0F, propExtend},
+	{0x10A10, 0x10A13, propALetter},
+	{0x10A15, 0x10A17, propALetter},
+	{0x10A19, 0x10A35, propALetter},
+	{0x10A38, 0x10A3A, propExtend},
+	{0x10A3F, 0x10A3F, propExtend},
+	{0x10A60, 0x10A7C, propALetter},
+	{0x10A80, 0x10A9C, propALetter},
+	{0x10AC0, 0x10AC7, propALetter},
+	{0x10AC9, 0x10AE4, propALetter},
+	{0x10AE5, 0x10AE6, propExtend},
+	{0x10B00, 0x10B35, propALetter},
+	{0x10B40, 0x10B55, propALetter},
+	{0x10B60, 0x10B72, propALetter},
+	{0x10B80, 0x10B91, propALetter},
+	{0x10C00, 0x10C48, propALetter},
+	{0x10C80, 0x10CB2, propALetter},
+	{0x10CC0, 0x10CF2, propALetter},
+	{0x10D00, 0x10D23, propALetter},
+	{0x10D24, 0x10D27, propExtend},
+	{0x10D30, 0x10D39, propNumeric},
+	{0x10D40, 0x10D49, propNumeric},
+	{0x10D4A, 0x10D65, propALetter},
+	{0x10D69, 0x10D6D, propExtend},
+	{0x10D6F, 0x10D85, propALetter},
+	{0x10E80, 0x10EA9, propALetter},
+	{0x10EAB, 0x10EAC, propExtend},
+	{0x10EB0, 0x10EB1, propALetter},
+	{0x10EC2, 0x10EC7, propALetter},
+	{0x10EFA, 0x10EFF, propExtend},
+	{0x10F00, 0x10F1C, propALetter},
+	{0x10F27, 0x10F27, propALetter},
+	{0x10F30, 0x10F45, propALetter},
+	{0x10F46, 0x10F50, propExtend},
+	{0x10F70, 0x10F81, propALetter},
+	{0x10F82, 0x10F85, propExtend},
+	{0x10FB0, 0x10FC4, propALetter},
+	{0x10FE0, 0x10FF6, propALetter},
+	{0x11000, 0x11002, propExtend},
+	{0x11003, 0x11037, propALetter},
+	{0x11038, 0x11046, propExtend},
+	{0x11066, 0x1106F, propNumeric},
+	{0x11070, 0x11070, propExtend},
+	{0x11071, 0x11072, propALetter},
+	{0x11073, 0x11074, propExtend},
+	{0x11075, 0x11075, propALetter},
+	{0x1107F, 0x11082, propExtend},
+	{0x11083, 0x110AF, propALetter},
+	{0x110B0, 0x110BA, propExtend},
+	{0x110BD, 0x110BD, propNumeric},
+	{0x110C2, 0x110C2, propExtend},
+	{0x110CD, 0x110CD, propNumeric},
+	{0x110D0, 0x110E8, propALetter},
+	{0x110F0, 0x110F9, propNumeric},
+	{0x11100, 0x11102, propExtend},
+	{0x11103, 0x11126, propALetter},
+	{0x11127, 0x11134, propExtend},
+	{0x11136, 0x1113F, propNumeric},
+	{0x11144, 0x11144, propALetter},
+	{0x11145, 0x11146, propExtend},
+	{0x11147, 0x11147, propALetter},
+	{0x11150, 0x11172, propALetter},
+	{0x11173, 0x11173, propExtend},
+	{0x11176, 0x11176, propALetter},
+	{0x11180, 0x11182, propExtend},
+	{0x11183, 0x111B2, propALetter},
+	{0x111B3, 0x111C0, propExtend},
+	{0x111C1, 0x111C4, propALetter},
+	{0x111C9, 0x111CC, propExtend},
+	{0x111CE, 0x111CF, propExtend},
+	{0x111D0, 0x111D9, propNumeric},
+	{0x111DA, 0x111DA, propALetter},
+	{0x111DC, 0x111DC, propALetter},
+	{0x11200, 0x11211, propALetter},
+	{0x11213, 0x1122B, propALetter},
+	{0x1122C, 0x11237, propExtend},
+	{0x1123E, 0x1123E, propExtend},
+	{0x1123F, 0x11240, propALetter},
+	{0x11241, 0x11241, propExtend},
+	{0x11280, 0x11286, propALetter},
+	{0x11288, 0x11288, propALetter},
+	{0x1128A, 0x1128D, propALetter},
+	{0x1128F, 0x1129D, propALetter},
+	{0x1129F, 0x112A8, propALetter},
+	{0x112B0, 0x112DE, propALetter},
+	{0x112DF, 0x112EA, propExtend},
+	{0x112F0, 0x112F9, propNumeric},
+	{0x11300, 0x11303, propExtend},
+	{0x11305, 0x1130C, propALetter},
+	{0x1130F, 0x11310, propALetter},
+	{0x11313, 0x11328, propALetter},
+	{0x1132A, 0x11330, propALetter},
+	{0x11332, 0x11333, propALetter},
+	{0x11335, 0x11339, propALetter},
+	{0x1133B, 0x1133C, propExtend},
+	{0x1133D, 0x1133D, propALetter},
+	{0x1133E, 0x11344, propExtend},
+	{0x11347, 0x11348, propExtend},
+	{0x1134B, 0x1134D, propExtend},
+	{0x11350, 0x11350, propALetter},
+	{0x11357, 0x11357, propExtend},
+	{0x1135D, 0x11361, propALetter},
+	{0x11362, 0x11363, propExtend},
+	{0x11366, 0x1136C, propExtend},
+	{0x11370, 0x11374, propExtend},
+	{0x11380, 0x11389, propALetter},
+	{0x1138B, 0x1138B, propALetter},
+	{0x1138E, 0x1138E, propALetter},
+	{0x11390, 0x113B5, propALetter},
+	{0x113B7, 0x113B7, propALetter},
+	{0x113B8, 0x113C0, propExtend},
+	{0x113C2, 0x113C2, propExtend},
+	{0x113C5, 0x113C5, propExtend},
+	{0x113C7, 0x113CA, propExtend},
+	{0x113CC, 0x113D0, propExtend},
+	{0x113D1, 0x113D1, propALetter},
+	{0x113D2, 0x113D2, propExtend},
+	{0x113D3, 0x113D3, propALetter},
+	{0x113E1, 0x113E2, propExtend},
+	{0x11400, 0x11434, propALetter},
+	{0x11435, 0x11446, propExtend},
+	{0x11447, 0x1144A, propALetter},
+	{0x11450, 0x11459, propNumeric},
+	{0x1145E, 0x1145E, propExtend},
+	{0x1145F, 0x11461, propALetter},
+	{0x11480, 0x114AF, propALetter},
+	{0x114B0, 0x114C3, propExtend},
+	{0x114C4, 0x114C5, propALetter},
+	{0x114C7, 0x114C7, propALetter},
+	{0x114D0, 0x114D9, propNumeric},
+	{0x11580, 0x115AE, propALetter},
+	{0x115AF, 0x115B5, propExtend},
+	{0x115B8, 0x115C0, propExtend},
+	{0x115D8, 0x115DB, propALetter},
+	{0x115DC, 0x115DD, propExtend},
+	{0x11600, 0x1162F, propALetter},
+	{0x11630, 0x11640, propExtend},
+	{0x11644, 0x11644, propALetter},
+	{0x11650, 0x11659, propNumeric},
+	{0x11680, 0x116AA, propALetter},
+	{0x116AB, 0x116B7, propExtend},
+	{0x116B8, 0x116B8, propALetter},
+	{0x116C0, 0x116C9, propNumeric},
+	{0x116D0, 0x116E3, propNumeric},
+	{0x1171D, 0x1172B, propExtend},
+	{0x11730, 0x11739, propNumeric},
+	{0x11800, 0x1182B, propALetter},
+	{0x1182C, 0x1183A, propExtend},
+	{0x118A0, 0x118DF, propALetter},
+	{0x118E0, 0x118E9, propNumeric},
+	{0x118FF, 0x11906, propALetter},
+	{0x11909, 0x11909, propALetter},
+	{0x1190C, 0x11913, propALetter},
+	{0x11915, 0x11916, propALetter},
+	{0x11918, 0x1192F, propALetter},
+	{0x11930, 0x11935, propExtend},
+	{0x11937, 0x11938, propExtend},
+	{0x1193B, 0x1193E, propExtend},
+	{0x1193F, 0x1193F, propALetter},
+	{0x11940, 0x11940, propExtend},
+	{0x11941, 0x11941, propALetter},
+	{0x11942, 0x11943, propExtend},
+	{0x11950, 0x11959, propNumeric},
+	{0x119A0, 0x119A7, propALetter},
+	{0x119AA, 0x119D0, propALetter},
+	{0x119D1, 0x119D7, propExtend},
+	{0x119DA, 0x119E0, propExtend},
+	{0x119E1, 0x119E1, propALetter},
+	{0x119E3, 0x119E3, propALetter},
+	{0x119E4, 0x119E4, propExtend},
+	{0x11A00, 0x11A00, propALetter},
+	{0x11A01, 0x11A0A, propExtend},
+	{0x11A0B, 0x11A32, propALetter},
+	{0x11A33, 0x11A39, propExtend},
+	{0x11A3A, 0x11A3A, propALetter},
+	{0x11A3B, 0x11A3E, propExtend},
+	{0x11A47, 0x11A47, propExtend},
+	{0x11A50, 0x11A50, propALetter},
+	{0x11A51, 0x11A5B, propExtend},
+	{0x11A5C, 0x11A89, propALetter},
+	{0x11A8A, 0x11A99, propExtend},
+	{0x11A9D, 0x11A9D, propALetter},
+	{0x11AB0, 0x11AF8, propALetter},
+	{0x11B60, 0x11B67, propExtend},
+	{0x11BC0, 0x11BE0, propALetter},
+	{0x11BF0, 0x11BF9, propNumeric},
+	{0x11C00, 0x11C08, propALetter},
+	{0x11C0A, 0x11C2E, propALetter},
+	{0x11C2F, 0x11C36, propExtend},
+	{0x11C38, 0x11C3F, propExtend},
+	{0x11C40, 0x11C40, propALetter},
+	{0x11C50, 0x11C59, propNumeric},
+	{0x11C72, 0x11C8F, propALetter},
+	{0x11C92, 0x11CA7, propExtend},
+	{0x11CA9, 0x11CB6, propExtend},
+	{0x11D00, 0x11D06, propALetter},
+	{0x11D08, 0x11D09, propALetter},
+	{0x11D0B, 0x11D30, propALetter},
+	{0x11D31, 0x11D36, propExtend},
+	{0x11D3A, 0x11D3A, propExtend},
+	{0x11D3C, 0x11D3D, propExtend},
+	{0x11D3F, 0x11D45, propExtend},
+	{0x11D46, 0x11D46, propALetter},
+	{0x11D47, 0x11D47, propExtend},
+	{0x11D50, 0x11D59, propNumeric},
+	{0x11D60, 0x11D65, propALetter},
+	{0x11D67, 0x11D68, propALetter},
+	{0x11D6A, 0x11D89, propALetter},
+	{0x11D8A, 0x11D8E, propExtend},
+	{0x11D90, 0x11D91, propExtend},
+	{0x11D93, 0x11D97, propExtend},
+	{0x11D98, 0x11D98, propALetter},
+	{0x11DA0, 0x11DA9, propNumeric},
+	{0x11DB0, 0x11DDB, propALetter},
+	{0x11DE0, 0x11DE9, propNumeric},
+	{0x11EE0, 0x11EF2, propALetter},
+	{0x11EF3, 0x11EF6, propExtend},
+	{0x11F00, 0x11F01, propExtend},
+	{0x11F02, 0x11F02, propALetter},
+	{0x11F03, 0x11F03, propExtend},
+	{0x11F04, 0x11F10, propALetter},
+	{0x11F12, 0x11F33, propALetter},
+	{0x11F34, 0x11F3A, propExtend},
+	{0x11F3E, 0x11F42, propExtend},
+	{0x11F50, 0x11F59, propNumeric},
+	{0x11F5A, 0x11F5A, propExtend},
+	{0x11FB0, 0x11FB0, propALetter},
+	{0x12000, 0x12399, propALetter},
+	{0x12400, 0x1246E, propALetter},
+	{0x12480, 0x12543, propALetter},
+	{0x12F90, 0x12FF0, propALetter},
+	{0x13000, 0x1342F, propALetter},
+	{0x13430, 0x1343F, propFormat},
+	{0x13440, 0x13440, propExtend},
+	{0x13441, 0x13446, propALetter},
+	{0x13447, 0x13455, propExtend},
+	{0x13460, 0x143FA, propALetter},
+	{0x14400, 0x14646, propALetter},
+	{0x16100, 0x1611D, propALetter},
+	{0x1611E, 0x1612F, propExtend},
+	{0x16130, 0x16139, propNumeric},
+	{0x16800, 0x16A38, propALetter},
+	{0x16A40, 0x16A5E, propALetter},
+	{0x16A60, 0x16A69, propNumeric},
+	{0x16A70, 0x16ABE, propALetter},
+	{0x16AC0, 0x16AC9, propNumeric},
+	{0x16AD0, 0x16AED, propALetter},
+	{0x16AF0, 0x16AF4, propExtend},
+	{0x16B00, 0x16B2F, propALetter},
+	{0x16B30, 0x16B36, propExtend},
+	{0x16B40, 0x16B43, propALetter},
+	{0x16B50, 0x16B59, propNumeric},
+	{0x16B63, 0x16B77, propALetter},
+	{0x16B7D, 0x16B8F, propALetter},
+	{0x16D40, 0x16D6C, propALetter},
+	{0x16D70, 0x16D79, propNumeric},
+	{0x16E40, 0x16E7F, propALetter},
+	{0x16EA0, 0x16EB8, propALetter},
+	{0x16EBB, 0x16ED3, propALetter},
+	{0x16F00, 0x16F4A, propALetter},
+	{0x16F4F, 0x16F4F, propExtend},
+	{0x16F50, 0x16F50, propALetter},
+	{0x16F51, 0x16F87, propExtend},
+	{0x16F8F, 0x16F92, propExtend},
+	{0x16F93, 0x16F9F, propALetter},
+	{0x16FE0, 0x16FE1, propALetter},
+	{0x16FE3, 0x16FE3, propALetter},
+	{0x16FE4, 0x16FE4, propExtend},
+	{0x16FF0, 0x16FF1, propExtend},
+	{0x1AFF0, 0x1AFF3, propKatakana},
+	{0x1AFF5, 0x1AFFB, propKatakana},
+	{0x1AFFD, 0x1AFFE, propKatakana},
+	{0x1B000, 0x1B000, propKatakana},
+	{0x1B120, 0x1B122, propKatakana},
+	{0x1B155, 0x1B155, propKatakana},
+	{0x1B164, 0x1B167, propKatakana},
+	{0x1BC00, 0x1BC6A, propALetter},
+	{0x1BC70, 0x1BC7C, propALetter},
+	{0x1BC80, 0x1BC88, propALetter},
+	{0x1BC90, 0x1BC99, propALetter},
+	{0x1BC9D, 0x1BC9E, propExtend},
+	{0x1BCA0, 0x1BCA3, propFormat},
+	{0x1CCF0, 0x1CCF9, propNumeric},
+	{0x1CF00, 0x1CF2D, propExtend},
+	{0x1CF30, 0x1CF46, propExtend},
+	{0x1D165, 0x1D169, propExtend},
+	{0x1D16D, 0x1D172, propExtend},
+	{0x1D173, 0x1D17A, propFormat},
+	{0x1D17B, 0x1D182, propExtend},
+	{0x1D185, 0x1D18B, propExtend},
+	{0x1D1AA, 0x1D1AD, propExtend},
+	{0x1D242, 0x1D244, propExtend},
+	{0x1D400, 0x1D454, propALetter},
+	{0x1D456, 0x1D49C, propALetter},
+	{0x1D49E, 0x1D49F, propALetter},
+	{0x1D4A2, 0x1D4A2, propALetter},
+	{0x1D4A5, 0x1D4A6, propALetter},
+	{0x1D4A9, 0x1D4AC, propALetter},
+	{0x1D4AE, 0x1D4B9, propALetter},
+	{0x1D4BB, 0x1D4BB, propALetter},
+	{0x1D4BD, 0x1D4C3, propALetter},
+	{0x1D4C5, 0x1D505, propALetter},
+	{0x1D507, 0x1D50A, propALetter},
+	{0x1D50D, 0x1D514, propALetter},
+	{0x1D516, 0x1D51C, propALetter},
+	{0x1D51E, 0x1D539, propALetter},
+	{0x1D53B, 0x1D53E, propALetter},
+	{0x1D540, 0x1D544, propALetter},
+	{0x1D546, 0x1D546, propALetter},
+	{0x1D54A, 0x1D550, propALetter},
+	{0x1D552, 0x1D6A5, propALetter},
+	{0x1D6A8, 0x1D6C0, propALetter},
+	{0x1D6C2, 0x1D6DA, propALetter},
+	{0x1D6DC, 0x1D6FA, propALetter},
+	{0x1D6FC, 0x1D714, propALetter},
+	{0x1D716, 0x1D734, propALetter},
+	{0x1D736, 0x1D74E, propALetter},
+	{0x1D750, 0x1D76E, propALetter},
+	{0x1D770, 0x1D788, propALetter},
+	{0x1D78A, 0x1D7A8, propALetter},
+	{0x1D7AA, 0x1D7C2, propALetter},
+	{0x1D7C4, 0x1D7CB, propALetter},
+	{0x1D7CE, 0x1D7FF, propNumeric},
+	{0x1DA00, 0x1DA36, propExtend},
+	{0x1DA3B, 0x1DA6C, propExtend},
+	{0x1DA75, 0x1DA75, propExtend},
+	{0x1DA84, 0x1DA84, propExtend},
+	{0x1DA9B, 0x1DA9F, propExtend},
+	{0x1DAA1, 0x1DAAF, propExtend},
+	{0x1DF00, 0x1DF1E, propALetter},
+	{0x1DF25, 0x1DF2A, propALetter},
+	{0x1E000, 0x1E006, propExtend},
+	{0x1E008, 0x1E018, propExtend},
+	{0x1E01B, 0x1E021, propExtend},
+	{0x1E023, 0x1E024, propExtend},
+	{0x1E026, 0x1E02A, propExtend},
+	{0x1E030, 0x1E06D, propALetter},
+	{0x1E08F, 0x1E08F, propExtend},
+	{0x1E100, 0x1E12C, propALetter},
+	{0x1E130, 0x1E136, propExtend},
+	{0x1E137, 0x1E13D, propALetter},
+	{0x1E140, 0x1E149, propNumeric},
+	{0x1E14E, 0x1E14E, propALetter},
+	{0x1E290, 0x1E2AD, propALetter},
+	{0x1E2AE, 0x1E2AE, propExtend},
+	{0x1E2C0, 0x1E2EB, propALetter},
+	{0x1E2EC, 0x1E2EF, propExtend},
+	{0x1E2F0, 0x1E2F9, propNumeric},
+	{0x1E4D0, 0x1E4EB, propALetter},
+	{0x1E4EC, 0x1E4EF, propExtend},
+	{0x1E4F0, 0x1E4F9, propNumeric},
+	{0x1E5D0, 0x1E5ED, propALetter},
+	{0x1E5EE, 0x1E5EF, propExtend},
+	{0x1E5F0, 0x1E5F0, propALetter},
+	{0x1E5F1, 0x1E5FA, propNumeric},
+	{0x1E6C0, 0x1E6DE, propALetter},
+	{0x1E6E0, 0x1E6E2, propALetter},
+	{0x1E6E3, 0x1E6E3, propExtend},
+	{0x1E6E4, 0x1E6E5, propALetter},
+	{0x1E6E6, 0x1E6E6, propExtend},
+	{0x1E6E7, 0x1E6ED, propALetter},
+	{0x1E6EE, 0x1E6EF, propExtend},
+	{0x1E6F0, 0x1E6F4, propALetter},
+	{0x1E6F5, 0x1E6F5, propExtend},
+	{0x1E6FE, 0x1E6FF, propALetter},
+	{0x1E7E0, 0x1E7E6, propALetter},
+	{0x1E7E8, 0x1E7EB, propALetter},
+	{0x1E7ED, 0x1E7EE, propALetter},
+	{0x1E7F0, 0x1E7FE, propALetter},
+	{0x1E800, 0x1E8C4, propALetter},
+	{0x1E8D0, 0x1E8D6, propExtend},
+	{0x1E900, 0x1E943, propALetter},
+	{0x1E944, 0x1E94A, propExtend},
+	{0x1E94B, 0x1E94B, propALetter},
+	{0x1E950, 0x1E959, propNumeric},
+	{0x1EE00, 0x1EE03, propALetter},
+	{0x1EE05, 0x1EE1F, propALetter},
+	{0x1EE21, 0x1EE22, propALetter},
+	{0x1EE24, 0x1EE24, propALetter},
+	{0x1EE27, 0x1EE27, propALetter},
+	{0x1EE29, 0x1EE32, propALetter},
+	{0x1EE34, 0x1EE37, propALetter},
+	{0x1EE39, 0x1EE39, propALetter},
+	{0x1EE3B, 0x1EE3B, propALetter},
+	{0x1EE42, 0x1EE42, propALetter},
+	{0x1EE47, 0x1EE47, propALetter},
+	{0x1EE49, 0x1EE49, propALetter},
+	{0x1EE4B, 0x1EE4B, propALetter},
+	{0x1EE4D, 0x1EE4F, propALetter},
+	{0x1EE51, 0x1EE52, propALetter},
+	{0x1EE54, 0x1EE54, propALetter},
+	{0x1EE57, 0x1EE57, propALetter},
+	{0x1EE59, 0x1EE59, propALetter},
+	{0x1EE5B, 0x1EE5B, propALetter},
+	{0x1EE5D, 0x1EE5D, propALetter},
+	{0x1EE5F, 0x1EE5F, propALetter},
+	{0x1EE61, 0x1EE62, propALetter},
+	{0x1EE64, 0x1EE64, propALetter},
+	{0x1EE67, 0x1EE6A, propALetter},
+	{0x1EE6C, 0x1EE72, propALetter},
+	{0x1EE74, 0x1EE77, propALetter},
+	{0x1EE79, 0x1EE7C, propALetter},
+	{0x1EE7E, 0x1EE7E, propALetter},
+	{0x1EE80, 0x1EE89, propALetter},
+	{0x1EE8B, 0x1EE9B, propALetter},
+	{0x1EEA1, 0x1EEA3, propALetter},
+	{0x1EEA5, 0x1EEA9, propALetter},
+	{0x1EEAB, 0x1EEBB, propALetter},
+	{0x1F004, 0x1F004, propExtPict},
+	{0x1F02C, 0x1F02F, propExtPict},
+	{0x1F094, 0x1F09F, propExtPict},
+	{0x1F0AF, 0x1F0B0, propExtPict},
+	{0x1F0C0, 0x1F0C0, propExtPict},
+	{0x1F0CF, 0x1F0D0, propExtPict},
+	{0x1F0F6, 0x1F0FF, propExtPict},
+	{0x1F130, 0x1F149, propALetter},
+	{0x1F150, 0x1F169, propALetter},
+	{0x1F170, 0x1F171, propALetter | propExtPict},
+	{0x1F172, 0x1F17D, propALetter},
+	{0x1F17E, 0x1F17F, propALetter | propExtPict},
+	{0x1F180, 0x1F189, propALetter},
+	{0x1F18E, 0x1F18E, propExtPict},
+	{0x1F191, 0x1F19A, propExtPict},
+	{0x1F1AE, 0x1F1E5, propExtPict},
+	{0x1F1E6, 0x1F1FF, propRI},
+	{0x1F201, 0x1F20F, propExtPict},
+	{0x1F21A, 0x1F21A, propExtPict},
+	{0x1F22F, 0x1F22F, propExtPict},
+	{0x1F232, 0x1F23A, propExtPict},
+	{0x1F23C, 0x1F23F, propExtPict},
+	{0x1F249, 0x1F25F, propExtPict},
+	{0x1F266, 0x1F321, propExtPict},
+	{0x1F324, 0x1F393, propExtPict},
+	{0x1F396, 0x1F397, propExtPict},
+	{0x1F399, 0x1F39B, propExtPict},
+	{0x1F39E, 0x1F3F0, propExtPict},
+	{0x1F3F3, 0x1F3F5, propExtPict},
+	{0x1F3F7, 0x1F3FA, propExtPict},
+	{0x1F3FB, 0x1F3FF, propExtend},
+	{0x1F400, 0x1F4FD, propExtPict},
+	{0x1F4FF, 0x1F53D, propExtPict},
+	{0x1F549, 0x1F54E, propExtPict},
+	{0x1F550, 0x1F567, propExtPict},
+	{0x1F56F, 0x1F570, propExtPict},
+	{0x1F573, 0x1F57A, propExtPict},
+	{0x1F587, 0x1F587, propExtPict},
+	{0x1F58A, 0x1F58D, propExtPict},
+	{0x1F590, 0x1F590, propExtPict},
+	{0x1F595, 0x1F596, propExtPict},
+	{0x1F5A4, 0x1F5A5, propExtPict},
+	{0x1F5A8, 0x1F5A8, propExtPict},
+	{0x1F5B1, 0x1F5B2, propExtPict},
+	{0x1F5BC, 0x1F5BC, propExtPict},
+	{0x1F5C2, 0x1F5C4, propExtPict},
+	{0x1F5D1, 0x1F5D3, propExtPict},
+	{0x1F5DC, 0x1F5DE, propExtPict},
+	{0x1F5E1, 0x1F5E1, propExtPict},
+	{0x1F5E3, 0x1F5E3, propExtPict},
+	{0x1F5E8, 0x1F5E8, propExtPict},
+	{0x1F5EF, 0x1F5EF, propExtPict},
+	{0x1F5F3, 0x1F5F3, propExtPict},
+	{0x1F5FA, 0x1F64F, propExtPict},
+	{0x1F680, 0x1F6C5, propExtPict},
+	{0x1F6CB, 0x1F6D2, propExtPict},
+	{0x1F6D5, 0x1F6E5, propExtPict},
+	{0x1F6E9, 0x1F6E9, propExtPict},
+	{0x1F6EB, 0x1F6F0, propExtPict},
+	{0x1F6F3, 0x1F6FF, propExtPict},
+	{0x1F7DA, 0x1F7FF, propExtPict},
+	{0x1F80C, 0x1F80F, propExtPict},
+	{0x1F848, 0x1F84F, propExtPict},
+	{0x1F85A, 0x1F85F, propExtPict},
+	{0x1F888, 0x1F88F, propExtPict},
+	{0x1F8AE, 0x1F8AF, propExtPict},
+	{0x1F8BC, 0x1F8BF, propExtPict},
+	{0x1F8C2, 0x1F8CF, propExtPict},
+	{0x1F8D9, 0x1F8FF, propExtPict},
+	{0x1F90C, 0x1F93A, propExtPict},
+	{0x1F93C, 0x1F945, propExtPict},
+	{0x1F947, 0x1F9FF, propExtPict},
+	{0x1FA58, 0x1FA5F, propExtPict},
+	{0x1FA6E, 0x1FAFF, propExtPict},
+	{0x1FBF0, 0x1FBF9, propNumeric},
+	{0x1FC00, 0x1FFFD, propExtPict},
+	{0xE0001, 0xE0001, propFormat},
+	{0xE0020, 0xE007F, propExtend},
+	{0xE0100, 0xE01EF, propExtend},
+}
+
+// Sentence_Break property
+var sentenceTable = []propRange{
+	{0x0009, 0x0009, propSp},
+	{0x000A, 0x000A, propLF},
+	{0x000B, 0x000C, propSp},
+	{0x000D, 0x000D, propCR},
+	{0x0020, 0x0020, propSp},
+	{0x0021, 0x0021, propSTerm},
+	{0x0022, 0x0022, propClose},
+	{0x0027, 0x0029, propClose},
+	{0x002C, 0x002D, propSContinue},
+	{0x002E, 0x002E, propATerm},
+	{0x0030, 0x0039, propNumeric},
+	{0x003A, 0x003B, propSContinue},
+	{0x003F, 0x003F, propSTerm},
+	{0x0041, 0x005A, propUpper},
+	{0x005B, 0x005B, propClose},
+	{0x005D, 0x005D, propClose},
+	{0x0061, 0x007A, propLower},
+	{0x007B, 0x007B, propClose},
+	{0x007D, 0x007D, propClose},
+	{0x0085, 0x0085, propSep},
+	{0x00A0, 0x00A0, propSp},
+	{0x00AA, 0x00AA, propLower},
+	{0x00AB, 0x00AB, propClose},
+	{0x00AD, 0x00AD, propFormat},
+	{0x00B5, 0x00B5, propLower},
+	{0x00BA, 0x00BA, propLower},
+	{0x00BB, 0x00BB, propClose},
+	{0x00C0, 0x00D6, propUpper},
+	{0x00D8, 0x00DE, propUpper},
+	{0x00DF, 0x00F6, propLower},
+	{0x00F8, 0x00FF, propLower},
+	{0x0100, 0x0100, propUpper},
+	{0x0101, 0x0101, propLower},
+	{0x0102, 0x0102, propUpper},
+	{0x0103, 0x0103, propLower},
+	{0x0104, 0x0104, propUpper},
+	{0x0105, 0x0105, propLower},
+	{0x0106, 0x0106, propUpper},
+	{0x0107, 0x0107, propLower},
+	{0x0108, 0x0108, propUpper},
+	{0x0109, 0x0109, propLower},
+	{0x010A, 0x010A, propUpper},
+	{0x010B, 0x010B, propLower},
+	{0x010C, 0x010C, propUpper},
+	{0x010D, 0x010D, propLower},
+	{0x010E, 0x010E, propUpper},
+	{0x010F, 0x010F, propLower},
+	{0x0110, 0x0110, propUpper},
+	{0x0111, 0x0111, propLower},
+	{0x0112, 0x0112, propUpper},
+	{0x0113, 0x0113, propLower},
+	{0x0114, 0x0114, propUpper},
+	{0x0115, 0x0115, propLower},
+	{0x0116, 0x0116, propUpper},
+	{0x0117, 0x0117, propLower},
+	{0x0118, 0x0118, propUpper},
+	{0x0119, 0x0119, propLower},
+	{0x011A, 0x011A, propUpper},
+	{0x011B, 0x011B, propLower},
+	{0x011C, 0x011C, propUpper},
+	{0x011D, 0x011D, propLower},
+	{0x011E, 0x011E, propUpper},
+	{0x011F, 0x011F, propLower},
+	{0x0120, 0x0120, propUpper},
+	{0x0121, 0x0121, propLower},
+	{0x0122, 0x0122, propUpper},
+	{0x0123, 0x0123, propLower},
+	{0x0124, 0x0124, propUpper},
+	{0x0125, 0x0125, propLower},
+	{0x0126, 0x0126, propUpper},
+	{0x0127, 0x0127, propLower},
+	{0x0128, 0x0128, propUpper},
+	{0x0129, 0x0129, propLower},
+	{0x012A, 0x012A, propUpper},
+	{0x012B, 0x012B, propLower},
+	{0x012C, 0x012C, propUpper},
+	{0x012D, 0x012D, propLower},
+	{0x012E, 0x012E, propUpper},
+	{0x012F, 0x012F, propLower},
+	{0x0130, 0x0130, propUpper},
+	{0x0131, 0x0131, propLower},
+	{0x0132, 0x0132, propUpper},
+	{0x0133, 0x0133, propLower},
+	{0x0134, 0x0134, propUpper},
+	{0x0135, 0x0135, propLower},
+	{0x0136, 0x0136, propUpper},
+	{0x0137, 0x0138, propLower},
+	{0x0139, 0x0139, propUpper},
+	{0x013A, 0x013A, propLower},
+	{0x013B, 0x013B, propUpper},
+	{0x013C, 0x013C, propLower},
+	{0x013D, 0x013D, propUpper},
+	{0x013E, 0x013E, propLower},
+	{0x013F, 0x013F, propUpper},
+	{0x0140, 0x0140, propLower},
+	{0x0141, 0x0141, propUpper},
+	{0x0142, 0x0142, propLower},
+	{0x0143, 0x0143, propUpper},
+	{0x0144, 0x0144, propLower},
+	{0x0145, 0x0145, propUpper},
+	{0x0146, 0x0146, propLower},
+	{0x0147, 0x0147, propUpper},
+	{0x0148, 0x0149, propLower},
+	{0x014A, 0x014A, propUpper},
+	{0x014B, 0x014B, propLower},
+	{0x014C, 0x014C, propUpper},
+	{0x014D, 0x014D, propLower},
+	{0x014E, 0x014E, propUpper},
+	{0x014F, 0x014F, propLower},
+	{0x0150, 0x0150, propUpper},
+	{0x0151, 0x0151, propLower},
+	{0x0152, 0x0152, propUpper},
+	{0x0153, 0x0153, propLower},
+	{0x0154, 0x0154, propUpper},
+	{0x0155, 0x0155, propLower},
+	{0x0156, 0x0156, propUpper},
+	{0x0157, 0x0157, propLower},
+	{0x0158, 0x0158, propUpper},
+	{0x0159, 0x0159, propLower},
+	{0x015A, 0x015A, propUpper},
+	{0x015B, 0x015B, propLower},
+	{0x015C, 0x015C, propUpper},
+	{0x015D, 0x015D, propLower},
+	{0x015E, 0x015E, propUpper},
+	{0x015F, 0x015F, propLower},
+	{0x0160, 0x0160, propUpper},
+	{0x0161, 0x0161, propLower},
+	{0x0162, 0x0162, propUpper},
+	{0x0163, 0x0163, propLower},
+	{0x0164, 0x0164, propUpper},
+	{0x0165, 0x0165, propLower},
+	{0x0166, 0x0166, propUpper},
+	{0x0167, 0x0167, propLower},
+	{0x0168, 0x0168, propUpper},
+	{0x0169, 0x0169, propLower},
+	{0x016A, 0x016A, propUpper},
+	{0x016B, 0x016B, propLower},
+	{0x016C, 0x016C, propUpper},
+	{0x016D, 0x016D, propLower},
+	{0x016E, 0x016E, propUpper},
+	{0x016F, 0x016F, propLower},
+	{0x0170, 0x0170, propUpper},
+	{0x0171, 0x0171, propLower},
+	{0x0172, 0x0172, propUpper},
+	{0x0173, 0x0173, propLower},
+	{0x0174, 0x0174, propUpper},
+	{0x0175, 0x0175, propLower},
+	{0x0176, 0x0176, propUpper},
+	{0x0177, 0x0177, propLower},
+	{0x0178, 0x0179, propUpper},
+	{0x017A, 0x017A, propLower},
+	{0x017B, 0x017B, propUpper},
+	{0x017C, 0x017C, propLower},
+	{0x017D, 0x017D, propUpper},
+	{0x017E, 0x0180, propLower},
+	{0x0181, 0x0182, propUpper},
+	{0x0183, 0x0183, propLower},
+	{0x0184, 0x0184, propUpper},
+	{0x0185, 0x0185, propLower},
+	{0x0186, 0x0187, propUpper},
+	{0x0188, 0x0188, propLower},
+	{0x0189, 0x018B, propUpper},
+	{0x018C, 0x018D, propLower},
+	{0x018E, 0x0191, propUpper},
+	{0x0192, 0x0192, propLower},
+	{0x0193, 0x0194, propUpper},
+	{0x0195, 0x0195, propLower},
+	{0x0196, 0x0198, propUpper},
+	{0x0199, 0x019B, propLower},
+	{0x019C, 0x019D, propUpper},
+	{0x019E, 0x019E, propLower},
+	{0x019F, 0x01A0, propUpper},
+	{0x01A1, 0x01A1, propLower},
+	{0x01A2, 0x01A2, propUpper},
+	{0x01A3, 0x01A3, propLower},
+	{0x01A4, 0x01A4, propUpper},
+	{0x01A5, 0x01A5, propLower},
+	{0x01A6, 0x01A7, propUpper},
+	{0x01A8, 0x01A8, propLower},
+	{0x01A9, 0x01A9, propUpper},
+	{0x01AA, 0x01AB, propLower},
+	{0x01AC, 0x01AC, propUpper},
+	{0x01AD, 0x01AD, propLower},
+	{0x01AE, 0x01AF, propUpper},
+	{0x01B0, 0x01B0, propLower},
+	{0x01B1, 0x01B3, propUpper},
+	{0x01B4, 0x01B4, propLower},
+	{0x01B5, 0x01B5, propUpper},
+	{0x01B6, 0x01B6, propLower},
+	{0x01B7, 0x01B8, propUpper},
+	{0x01B9, 0x01BA, propLower},
+	{0x01BB, 0x01BB, propOLetter},
+	{0x01BC, 0x01BC, propUpper},
+	{0x01BD, 0x01BF, propLower},
+	{0x01C0, 0x01C3, propOLetter},
+	{0x01C4, 0x01C5, propUpper},
+	{0x01C6, 0x01C6, propLower},
+	{0x01C7, 0x01C8, propUpper},
+	{0x01C9, 0x01C9, propLower},
+	{0x01CA, 0x01CB, propUpper},
+	{0x01CC, 0x01CC, propLower},
+	{0x01CD, 0x01CD, propUpper},
+	{0x01CE, 0x01CE, propLower},
+	{0x01CF, 0x01CF, propUpper},
+	{0x01D0, 0x01D0, propLower},
+	{0x01D1, 0x01D1, propUpper},
+	{0x01D2, 0x01D2, propLower},
+	{0x01D3, 0x01D3, propUpper},
+	{0x01D4, 0x01D4, propLower},
+	{0x01D5, 0x01D5, propUpper},
+	{0x01D6, 0x01D6, propLower},
+	{0x01D7, 0x01D7, propUpper},
+	{0x01D8, 0x01D8, propLower},
+	{0x01D9, 0x01D9, propUpper},
+	{0x01DA, 0x01DA, propLower},
+	{0x01DB, 0x01DB, propUpper},
+	{0x01DC, 0x01DD, propLower},
+	{0x01DE, 0x01DE, propUpper},
+	{0x01DF, 0x01DF, propLower},
+	{0x01E0, 0x01E0, propUpper},
+	{0x01E1, 0x01E1, propLower},
+	{0x01E2, 0x01E2, propUpper},
+	{0x01E3, 0x01E3, propLower},
+	{0x01E4, 0x01E4, propUpper},
+	{0x01E5, 0x01E5, propLower},
+	{0x01E6, 0x01E6, propUpper},
+	{0x01E7, 0x01E7, propLower},
+	{0x01E8, 0x01E8, propUpper},
+	{0x01E9, 0x01E9, propLower},
+	{0x01EA, 0x01EA, propUpper},
+	{0x01EB, 0x01EB, propLower},
+	{0x01EC, 0x01EC, propUpper},
+	{0x01ED, 0x01ED, propLower},
+	{0x01EE, 0x01EE, propUpper},
+	{0x01EF, 0x01F0, propLower},
+	{0x01F1, 0x01F2, propUpper},
+	{0x01F3, 0x01F3, propLower},
+	{0x01F4, 0x01F4, propUpper},
+	{0x01F5, 0x01F5, propLower},
+	{0x01F6, 0x01F8, propUpper},
+	{0x01F9, 0x01F9, propLower},
+	{0x01FA, 0x01FA, propUpper},
+	{0x01FB, 0x01FB, propLower},
+	{0x01FC, 0x01FC, propUpper},
+	{0x01FD, 0x01FD, propLower},
+	{0x01FE, 0x01FE, propUpper},
+	{0x01FF, 0x01FF, propLower},
+	{0x0200, 0x0200, propUpper},
+	{0x0201, 0x0201, propLower},
+	{0x0202, 0x0202, propUpper},
+	{0x0203, 0x0203, propLower},
+	{0x0204, 0x0204, propUpper},
+	{0x0205, 0x0205, propLower},
+	{0x0206, 0x0206, propUpper},
+	{0x0207, 0x0207, propLower},
+	{0x0208, 0x0208, propUpper},
+	{0x0209, 0x0209, propLower},
+	{0x020A, 0x020A, propUpper},
+	{0x020B, 0x020B, propLower},
+	{0x020C, 0x020C, propUpper},
+	{0x020D, 0x020D, propLower},
+	{0x020E, 0x020E, propUpper},
+	{0x020F, 0x020F, propLower},
+	{0x0210, 0x0210, propUpper},
+	{0x0211, 0x0211, propLower},
+	{0x0212, 0x0212, propUpper},
+	{0x0213, 0x0213, propLower},
+	{0x0214, 0x0214, propUpper},
+	{0x0215, 0x0215, propLower},
+	{0x0216, 0x0216, propUpper},
+	{0x0217, 0x0217, propLower},
+	{0x0218, 0x0218, propUpper},
+	{0x0219, 0x0219, propLower},
+	{0x021A, 0x021A, propUpper},
+	{0x021B, 0x021B, propLower},
+	{0x021C, 0x021C, propUpper},
+	{0x021D, 0x021D, propLower},
+	{0x021E, 0x021E, propUpper},
+	{0x021F, 0x021F, propLower},
+	{0x0220, 0x0220, propUpper},
+	{0x0221, 0x0221, propLower},
+	{0x0222, 0x0222, propUpper},
+	{0x0223, 0x0223, propLower},
+	{0x0224, 0x0224, propUpper},
+	{0x0225, 0x0225, propLower},
+	{0x0226, 0x0226, propUpper},
+	{0x0227, 0x0227, propLower},
+	{0x0228, 0x0228, propUpper},
+	{0x0229, 0x0229, propLower},
+	{0x022A, 0x022A, propUpper},
+	{0x022B, 0x022B, propLower},
+	{0x022C, 0x022C, propUpper},
+	{0x022D, 0x022D, propLower},
+	{0x022E, 0x022E, propUpper},
+	{0x022F, 0x022F, propLower},
+	{0x0230, 0x0230, propUpper},
+	{0x0231, 0x0231, propLower},
+	{0x0232, 0x0232, propUpper},
+	{0x0233, 0x0239, propLower},
+	{0x023A, 0x023B, propUpper},
+	{0x023C, 0x023C, propLower},
+	{0x023D, 0x023E, propUpper},
+	{0x023F, 0x0240, propLower},
+	{0x0241, 0x0241, propUpper},
+	{0x0242, 0x0242, propLower},
+	{0x0243, 0x0246, propUpper},
+	{0x0247, 0x0247, propLower},
+	{0x0248, 0x0248, propUpper},
+	{0x0249, 0x0249, propLower},
+	{0x024A, 0x024A, propUpper},
+	{0x024B, 0x024B, propLower},
+	{0x024C, 0x024C, propUpper},
+	{0x024D, 0x024D, propLower},
+	{0x024E, 0x024E, propUpper},
+	{0x024F, 0x0293, propLower},
+	{0x0294, 0x0295, propOLetter},
+	{0x0296, 0x02B8, propLower},
+	{0x02B9, 0x02BF, propOLetter},
+	{0x02C0, 0x02C1, propLower},
+	{0x02C6, 0x02D1, propOLetter},
+	{0x02E0, 0x02E4, propLower},
+	{0x02EC, 0x02EC, propOLetter},
+	{0x02EE, 0x02EE, propOLetter},
+	{0x0300, 0x036F, propExtend},
+	{0x0370, 0x0370, propUpper},
+	{0x0371, 0x0371, propLower},
+	{0x0372, 0x0372, propUpper},
+	{0x0373, 0x0373, propLower},
+	{0x0374, 0x0374, propOLetter},
+	{0x0376, 0x0376, propUpper},
+	{0x0377, 0x0377, propLower},
+	{0x037A, 0x037D, propLower},
+	{0x037E, 0x037E, propSContinue},
+	{0x037F, 0x037F, propUpper},
+	{0x0386, 0x0386, propUpper},
+	{0x0388, 0x038A, propUpper},
+	{0x038C, 0x038C, propUpper},
+	{0x038E, 0x038F, propUpper},
+	{0x0390, 0x0390, propLower},
+	{0x0391, 0x03A1, propUpper},
+	{0x03A3, 0x03AB, propUpper},
+	{0x03AC, 0x03CE, propLower},
+	{0x03CF, 0x03CF, propUpper},
+	{0x03D0, 0x03D1, propLower},
+	{0x03D2, 0x03D4, propUpper},
+	{0x03D5, 0x03D7, propLower},
+	{0x03D8, 0x03D8, propUpper},
+	{0x03D9, 0x03D9, propLower},
+	{0x03DA, 0x03DA, propUpper},
+	{0x03DB, 0x03DB, propLower},
+	{0x03DC, 0x03DC, propUpper},
+	{0x03DD, 0x03DD, propLower},
+	{0x03DE, 0x03DE, propUpper},
+	{0x03DF, 0x03DF, propLower},
+	{0x03E0, 0x03E0, propUpper},
+	{0x03E1, 0x03E1, propLower},
+	{0x03E2, 0x03E2, propUpper},
+	{0x03E3, 0x03E3, propLower},
+	{0x03E4, 0x03E4, propUpper},
+	{0x03E5, 0x03E5, propLower},
+	{0x03E6, 0x03E6, propUpper},
+	{0x03E7, 0x03E7, propLower},
+	{0x03E8, 0x03E8, propUpper},
+	{0x03E9, 0x03E9, propLower},
+	{0x03EA, 0x03EA, propUpper},
+	{0x03EB, 0x03EB, propLower},
+	{0x03EC, 0x03EC, propUpper},
+	{0x03ED, 0x03ED, propLower},
+	{0x03EE, 0x03EE, propUpper},
+	{0x03EF, 0x03F3, propLower},
+	{0x03F4, 0x03F4, propUpper},
+	{0x03F5, 0x03F5, propLower},
+	{0x03F7, 0x03F7, propUpper},
+	{0x03F8, 0x03F8, propLower},
+	{0x03F9, 0x03FA, propUpper},
+	{0x03FB, 0x03FC, propLower},
+	{0x03FD, 0x042F, propUpper},
+	{0x0430, 0x045F, propLower},
+	{0x0460, 0x0460, propUpper},
+	{0x0461, 0x0461, propLower},
+	{0x0462, 0x0462, propUpper},
+	{0x0463, 0x0463, propLower},
+	{0x0464, 0x0464, propUpper},
+	{0x0465, 0x0465, propLower},
+	{0x0466, 0x0466, propUpper},
+	{0x0467, 0x0467, propLower},
+	{0x0468, 0x0468, propUpper},
+	{0x0469, 0x0469, propLower},
+	{0x046A, 0x046A, propUpper},
+	{0x046B, 0x046B, propLower},
+	{0x046C, 0x046C, propUpper},
+	{0x046D, 0x046D, propLower},
+	{0x046E, 0x046E, propUpper},
+	{0x046F, 0x046F, propLower},
+	{0x0470, 0x0470, propUpper},
+	{0x0471, 0x0471, propLower},
+	{0x0472, 0x0472, propUpper},
+	{0x0473, 0x0473, propLower},
+	{0x0474, 0x0474, propUpper},
+	{0x0475, 0x0475, propLower},
+	{0x0476, 0x0476, propUpper},
+	{0x0477, 0x0477, propLower},
+	{0x0478, 0x0478, propUpper},
+	{0x0479, 0x0479, propLower},
+	{0x047A, 0x047A, propUpper},
+	{0x047B, 0x047B, propLower},
+	{0x047C, 0x047C, propUpper},
+	{0x047D, 0x047D, propLower},
+	{0x047E, 0x047E, propUpper},
+	{0x047F, 0x047F, propLower},
+	{0x0480, 0x0480, propUpper},
+	{0x0481, 0x0481, propLower},
+	{0x0483, 0x0489, propExtend},
+	{0x048A, 0x048A, propUpper},
+	{0x048B, 0x048B, propLower},
+	{0x048C, 0x048C, propUpper},
+	{0x048D, 0x048D, propLower},
+	{0x048E, 0x048E, propUpper},
+	{0x048F, 0x048F, propLower},
+	{0x0490, 0x0490, propUpper},
+	{0x0491, 0x0491, propLower},
+	{0x0492, 0x0492, propUpper},
+	{0x0493, 0x0493, propLower},
+	{0x0494, 0x0494, propUpper},
+	{0x0495, 0x0495, propLower},
+	{0x0496, 0x0496, propUpper},
+	{0x0497, 0x0497, propLower},
+	{0x0498, 0x0498, propUpper},
+	{0x0499, 0x0499, propLower},
+	{0x049A, 0x049A, propUpper},
+	{0x049B, 0x049B, propLower},
+	{0x049C, 0x049C, propUpper},
+	{0x049D, 0x049D, propLower},
+	{0x049E, 0x049E, propUpper},
+	{0x049F, 0x049F, propLower},
+	{0x04A0, 0x04A0, propUpper},
+	{0x04A1, 0x04A1, propLower},
+	{0x04A2, 0x04A2, propUpper},
+	{0x04A3, 0x04A3, propLower},
+	{0x04A4, 0x04A4, propUpper},
+	{0x04A5, 0x04A5, propLower},
+	{0x04A6, 0x04A6, propUpper},
+	{0x04A7, 0x04A7, propLower},
+	{0x04A8, 0x04A8, propUpper},
+	{0x04A9, 0x04A9, propLower},
+	{0x04AA, 0x04AA, propUpper},
+	{0x04AB, 0x04AB, propLower},
+	{0x04AC, 0x04AC, propUpper},
+	{0x04AD, 0x04AD, propLower},
+	{0x04AE, 0x04AE, propUpper},
+	{0x04AF, 0x04AF, propLower},
+	{0x04B0, 0x04B0, propUpper},
+	{0x04B1, 0x04B1, propLower},
+	{0x04B2, 0x04B2, propUpper},
+	{0x04B3, 0x04B3, propLower},
+	{0x04B4, 0x04B4, propUpper},
+	{0x04B5, 0x04B5, propLower},
+	{0x04B6, 0x04B6, propUpper},
+	{0x04B7, 0x04B7, propLower},
+	{0x04B8, 0x04B8, propUpper},
+	{0x04B9, 0x04B9, propLower},
+	{0x04BA, 0x04BA, propUpper},
+	{0x04BB, 0x04BB, propLower},
+	{0x04BC, 0x04BC, propUpper},
+	{0x04BD, 0x04BD, propLower},
+	{0x04BE, 0x04BE, propUpper},
+	{0x04BF, 0x04BF, propLower},
+	{0x04C0, 0x04C1, propUpper},
+	{0x04C2, 0x04C2, propLower},
+	{0x04C3, 0x04C3, propUpper},
+	{0x04C4, 0x04C4, propLower},
+	{0x04C5, 0x04C5, propUpper},
+	{0x04C6, 0x04C6, propLower},
+	{0x04C7, 0x04C7, propUpper},
+	{0x04C8, 0x04C8, propLower},
+	{0x04C9, 0x04C9, propUpper},
+	{0x04CA, 0x04CA, propLower},
+	{0x04CB, 0x04CB, propUpper},
+	{0x04CC, 0x04CC, propLower},
+	{0x04CD, 0x04CD, propUpper},
+	{0x04CE, 0x04CF, propLower},
+	{0x04D0, 0x04D0, propUpper},
+	{0x04D1, 0x04D1, propLower},
+	{0x04D2, 0x04D2, propUpper},
+	{0x04D3, 0x04D3, propLower},
+	{0x04D4, 0x04D4, propUpper},
+	{0x04D5, 0x04D5, propLower},
+	{0x04D6, 0x04D6, propUpper},
+	{0x04D7, 0x04D7, propLower},
+	{0x04D8, 0x04D8, propUpper},
+	{0x04D9, 0x04D9, propLower},
+	{0x04DA, 0x04DA, propUpper},
+	{0x04DB, 0x04DB, propLower},
+	{0x04DC, 0x04DC, propUpper},
+	{0x04DD, 0x04DD, propLower},
+	{0x04DE, 0x04DE, propUpper},
+	{0x04DF, 0x04DF, propLower},
+	{0x04E0, 0x04E0, propUpper},
+	{0x04E1, 0x04E1, propLower},
+	{0x04E2, 0x04E2, propUpper},
+	{0x04E3, 0x04E3, propLower},
+	{0x04E4, 0x04E4, propUpper},
+	{0x04E5, 0x04E5, propLower},
+	{0x04E6, 0x04E6, propUpper},
+	{0x04E7, 0x04E7, propLower},
+	{0x04E8, 0x04E8, propUpper},
+	{0x04E9, 0x04E9, propLower},
+	{0x04EA, 0x04EA, propUpper},
+	{0x04EB, 0x04EB, propLower},
+	{0x04EC, 0x04EC, propUpper},
+	{0x04ED, 0x04ED, propLower},
+	{0x04EE, 0x04EE, propUpper},
+	{0x04EF, 0x04EF, propLower},
+	{0x04F0, 0x04F0, propUpper},
+	{0x04F1, 0x04F1, propLower},
+	{0x04F2, 0x04F2, propUpper},
+	{0x04F3, 0x04F3, propLower},
+	{0x04F4, 0x04F4, propUpper},
+	{0x04F5, 0x04F5, propLower},
+	{0x04F6, 0x04F6, propUpper},
+	{0x04F7, 0x04F7, propLower},
+	{0x04F8, 0x04F8, propUpper},
+	{0x04F9, 0x04F9, propLower},
+	{0x04FA, 0x04FA, propUpper},
+	{0x04FB, 0x04FB, propLower},
+	{0x04FC, 0x04FC, propUpper},
+	{0x04FD, 0x04FD, propLower},
+	{0x04FE, 0x04FE, propUpper},
+	{0x04FF, 0x04FF, propLower},
+	{0x0500, 0x0500, propUpper},
+	{0x0501, 0x0501, propLower},
+	{0x0502, 0x0502, propUpper},
+	{0x0503, 0x0503, propLower},
+	{0x0504, 0x0504, propUpper},
+	{0x0505, 0x0505, propLower},
+	{0x0506, 0x0506, propUpper},
+	{0x0507, 0x0507, propLower},
+	{0x0508, 0x0508, propUpper},
+	{0x0509, 0x0509, propLower},
+	{0x050A, 0x050A, propUpper},
+	{0x050B, 0x050B, propLower},
+	{0x050C, 0x050C, propUpper},
+	{0x050D, 0x050D, propLower},
+	{0x050E, 0x050E, propUpper},
+	{0x050F, 0x050F, propLower},
+	{0x0510, 0x0510, propUpper},
+	{0x0511, 0x0511, propLower},
+	{0x0512, 0x0512, propUpper},
+	{0x0513, 0x0513, propLower},
+	{0x0514, 0x0514, propUpper},
+	{0x0515, 0x0515, propLower},
+	{0x0516, 0x0516, propUpper},
+	{0x0517, 0x0517, propLower},
+	{0x0518, 0x0518, propUpper},
+	{0x0519, 0x0519, propLower},
+	{0x051A, 0x051A, propUpper},
+	{0x051B, 0x051B, propLower},
+	{0x051C, 0x051C, propUpper},
+	{0x051D, 0x051D, propLower},
+	{0x051E, 0x051E, propUpper},
+	{0x051F, 0x051F, propLower},
+	{0x0520, 0x0520, propUpper},
+	{0x0521, 0x0521, propLower},
+	{0x0522, 0x0522, propUpper},
+	{0x0523, 0x0523, propLower},
+	{0x0524, 0x0524, propUpper},
+	{0x0525, 0x0525, propLower},
+	{0x0526, 0x0526, propUpper},
+	{0x0527, 0x0527, propLower},
+	{0x0528, 0x0528, propUpper},
+	{0x0529, 0x0529, propLower},
+	{0x052A, 0x052A, propUpper},
+	{0x052B, 0x052B, propLower},
+	{0x052C, 0x052C, propUpper},
+	{0x052D, 0x052D, propLower},
+	{0x052E, 0x052E, propUpper},
+	{0x052F, 0x052F, propLower},
+	{0x0531, 0x0556, propUpper},
+	{0x0559, 0x0559, propOLetter},
+	{0x055D, 0x055D, propSContinue},
+	{0x0560, 0x0588, propLower},
+	{0x0589, 0x0589, propSTerm},
+	{0x0591, 0x05BD, propExtend},
+	{0x05BF, 0x05BF, propExtend},
+	{0x05C1, 0x05C2, propExtend},
+	{0x05C4, 0x05C5, propExtend},
+	{0x05C7, 0x05C7, propExtend},
+	{0x05D0, 0x05EA, propOLetter},
+	{0x05EF, 0x05F3, propOLetter},
+	{0x0600, 0x0605, propNumeric},
+	{0x060C, 0x060D, propSContinue},
+	{0x0610, 0x061A, propExtend},
+	{0x061C, 0x061C, propFormat},
+	{0x061D, 0x061F, propSTerm},
+	{0x0620, 0x064A, propOLetter},
+	{0x064B, 0x065F, propExtend},
+	{0x0660, 0x0669, propNumeric},
+	{0x066B, 0x066C, propNumeric},
+	{0x066E, 0x066F, propOLetter},
+	{0x0670, 0x0670, propExtend},
+	{0x0671, 0x06D3, propOLetter},
+	{0x06D4, 0x06D4, propSTerm},
+	{0x06D5, 0x06D5, propOLetter},
+	{0x06D6, 0x06DC, propExtend},
+	{0x06DD, 0x06DD, propNumeric},
+	{0x06DF, 0x06E4, propExtend},
+	{0x06E5, 0x06E6, propOLetter},
+	{0x06E7, 0x06E8, propExtend},
+	{0x06EA, 0x06ED, propExtend},
+	{0x06EE, 0x06EF, propOLetter},
+	{0x06F0, 0x06F9, propNumeric},
+	{0x06FA, 0x06FC, propOLetter},
+	{0x06FF, 0x06FF, propOLetter},
+	{0x0700, 0x0702, propSTerm},
+	{0x070F, 0x070F, propFormat},
+	{0x0710, 0x0710, propOLetter},
+	{0x0711, 0x0711, propExtend},
+	{0x0712, 0x072F, propOLetter},
+	{0x0730, 0x074A, propExtend},
+	{0x074D, 0x07A5, propOLetter},
+	{0x07A6, 0x07B0, propExtend},
+	{0x07B1, 0x07B1, propOLetter},
+	{0x07C0, 0x07C9, propNumeric},
+	{0x07CA, 0x07EA, propOLetter},
+	{0x07EB, 0x07F3, propExtend},
+	{0x07F4, 0x07F5, propOLetter},
+	{0x07F8, 0x07F8, propSContinue},
+	{0x07F9, 0x07F9, propSTerm},
+	{0x07FA, 0x07FA, propOLetter},
+	{0x07FD, 0x07FD, propExtend},
+	{0x0800, 0x0815, propOLetter},
+	{0x0816, 0x0819, propExtend},
+	{0x081A, 0x081A, propOLetter},
+	{0x081B, 0x0823, propExtend},
+	{0x0824, 0x0824, propOLetter},
+	{0x0825, 0x0827, propExtend},
+	{0x0828, 0x0828, propOLetter},
+	{0x0829, 0x082D, propExtend},
+	{0x0837, 0x0837, propSTerm},
+	{0x0839, 0x0839, propSTerm},
+	{0x083D, 0x083E, propSTerm},
+	{0x0840, 0x0858, propOLetter},
+	{0x0859, 0x085B, propExtend},
+	{0x0860, 0x086A, propOLetter},
+	{0x0870, 0x0887, propOLetter},
+	{0x0889, 0x088F, propOLetter},
+	{0x0890, 0x0891, propNumeric},
+	{0x0897, 0x089F, propExtend},
+	{0x08A0, 0x08C9, propOLetter},
+	{0x08CA, 0x08E1, propExtend},
+	{0x08E2, 0x08E2, propNumeric},
+	{0x08E3, 0x0903, propExtend},
+	{0x0904, 0x0939, propOLetter},
+	{0x093A, 0x093C, propExtend},
+	{0x093D, 0x093D, propOLetter},
+	{0x093E, 0x094F, propExtend},
+	{0x0950, 0x0950, propOLetter},
+	{0x0951, 0x0957, propExtend},
+	{0x0958, 0x0961, propOLetter},
+	{0x0962, 0x0963, propExtend},
+	{0x0964, 0x0965, propSTerm},
+	{0x0966, 0x096F, propNumeric},
+	{0x0971, 0x0980, propOLetter},
+	{0x0981, 0x0983, propExtend},
+	{0x0985, 0x098C, propOLetter},
+	{0x098F, 0x0990, propOLetter},
+	{0x0993, 0x09A8, propOLetter},
+	{0x09AA, 0x09B0, propOLetter},
+	{0x09B2, 0x09B2, propOLetter},
+	{0x09B6, 0x09B9, propOLetter},
+	{0x09BC, 0x09BC, propExtend},
+	{0x09BD, 0x09BD, propOLetter},
+	{0x09BE, 0x09C4, propExtend},
+	{0x09C7, 0x09C8, propExtend},
+	{0x09CB, 0x09CD, propExtend},
+	{0x09CE, 0x09CE, propOLetter},
+	{0x09D7, 0x09D7, propExtend},
+	{0x09DC, 0x09DD, propOLetter},
+	{0x09DF, 0x09E1, propOLetter},
+	{0x09E2, 0x09E3, propExtend},
+	{0x09E6, 0x09EF, propNumeric},
+	{0x09F0, 0x09F1, propOLetter},
+	{0x09FC, 0x09FC, propOLetter},
+	{0x09FE, 0x09FE, propExtend},
+	{0x0A01, 0x0A03, propExtend},
+	{0x0A05, 0x0A0A, propOLetter},
+	{0x0A0F, 0x0A10, propOLetter},
+	{0x0A13, 0x0A28, propOLetter},
+	{0x0A2A, 0x0A30, propOLetter},
+	{0x0A32, 0x0A33, propOLetter},
+	{0x0A35, 0x0A36, propOLetter},
+	{0x0A38, 0x0A39, propOLetter},
+	{0x0A3C, 0x0A3C, propExtend},
+	{0x0A3E, 0x0A42, propExtend},
+	{0x0A47, 0x0A48, propExtend},
+	{0x0A4B, 0x0A4D, propExtend},
+	{0x0A51, 0x0A51, propExtend},
+	{0x0A59, 0x0A5C, propOLetter},
+	{0x0A5E, 0x0A5E, propOLetter},
+	{0x0A66, 0x0A6F, propNumeric},
+	{0x0A70, 0x0A71, propExtend},
+	{0x0A72, 0x0A74, propOLetter},
+	{0x0A75, 0x0A75, propExtend},
+	{0x0A81, 0x0A83, propExtend},
+	{0x0A85, 0x0A8D, propOLetter},
+	{0x0A8F, 0x0A91, propOLetter},
+	{0x0A93, 0x0AA8, propOLetter},
+	{0x0AAA, 0x0AB0, propOLetter},
+	{0x0AB2, 0x0AB3, propOLetter},
+	{0x0AB5, 0x0AB9, propOLetter},
+	{0x0ABC, 0x0ABC, propExtend},
+	{0x0ABD, 0x0ABD, propOLetter},
+	{0x0ABE, 0x0AC5, propExtend},
+	{0x0AC7, 0x0AC9, propExtend},
+	{0x0ACB, 0x0ACD, propExtend},
+	{0x0AD0, 0x0AD0, propOLetter},
+	{0x0AE0, 0x0AE1, propOLetter},
+	{0x0AE2, 0x0AE3, propExtend},
+	{0x0AE6, 0x0AEF, propNumeric},
+	{0x0AF9, 0x0AF9, propOLetter},
+	{0x0AFA, 0x0AFF, propExtend},
+	{0x0B01, 0x0B03, propExtend},
+	{0x0B05, 0x0B0C, propOLetter},
+	{0x0B0F, 0x0B10, propOLetter},
+	{0x0B13, 0x0B28, propOLetter},
+	{0x0B2A, 0x0B30, propOLetter},
+	{0x0B32, 0x0B33, propOLetter},
+	{0x0B35, 0x0B39, propOLetter},
+	{0x0B3C, 0x0B3C, propExtend},
+	{0x0B3D, 0x0B3D, propOLetter},
+	{0x0B3E, 0x0B44, propExtend},
+	{0x0B47, 0x0B48, propExtend},
+	{0x0B4B, 0x0B4D, propExtend},
+	{0x0B55, 0x0B57, propExtend},
+	{0x0B5C, 0x0B5D, propOLetter},
+	{0x0B5F, 0x0B61, propOLetter},
+	{0x0B62, 0x0B63, propExtend},
+	{0x0B66, 0x0B6F, propNumeric},
+	{0x0B71, 0x0B71, propOLetter},
+	{0x0B82, 0x0B82, propExtend},
+	{0x0B83, 0x0B83, propOLetter},
+	{0x0B85, 0x0B8A, propOLetter},
+	{0x0B8E, 0x0B90, propOLetter},
+	{0x0B92, 0x0B95, propOLetter},
+	{0x0B99, 0x0B9A, propOLetter},
+	{0x0B9C, 0x0B9C, propOLetter},
+	{0x0B9E, 0x0B9F, propOLetter},
+	{0x0BA3, 0x0BA4, propOLetter},
+	{0x0BA8, 0x0BAA, propOLetter},
+	{0x0BAE, 0x0BB9, propOLetter},
+	{0x0BBE, 0x0BC2, propExtend},
+	{0x0BC6, 0x0BC8, propExtend},
+	{0x0BCA, 0x0BCD, propExtend},
+	{0x0BD0, 0x0BD0, propOLetter},
+	{0x0BD7, 0x0BD7, propExtend},
+	{0x0BE6, 0x0BEF, propNumeric},
+	{0x0C00, 0x0C04, propExtend},
+	{0x0C05, 0x0C0C, propOLetter},
+	{0x0C0E, 0x0C10, propOLetter},
+	{0x0C12, 0x0C28, propOLetter},
+	{0x0C2A, 0x0C39, propOLetter},
+	{0x0C3C, 0x0C3C, propExtend},
+	{0x0C3D, 0x0C3D, propOLetter},
+	{0x0C3E, 0x0C44, propExtend},
+	{0x0C46, 0x0C48, propExtend},
+	{0x0C4A, 0x0C4D, propExtend},
+	{0x0C55, 0x0C56, propExtend},
+	{0x0C58, 0x0C5A, propOLetter},
+	{0x0C5C, 0x0C5D, propOLetter},
+	{0x0C60, 0x0C61, propOLetter},
+	{0x0C62, 0x0C63, propExtend},
+	{0x0C66, 0x0C6F, propNumeric},
+	{0x0C80, 0x0C80, propOLetter},
+	{0x0C81, 0x0C83, propExtend},
+	{0x0C85, 0x0C8C, propOLetter},
+	{0x0C8E, 0x0C90, propOLetter},
+	{0x0C92, 0x0CA8, propOLetter},
+	{0x0CAA, 0x0CB3, propOLetter},
+	{0x0CB5, 0x0CB9, propOLetter},
+	{0x0CBC, 0x0CBC, propExtend},
+	{0x0CBD, 0x0CBD, propOLetter},
+	{0x0CBE, 0x0CC4, propExtend},
+	{0x0CC6, 0x0CC8, propExtend},
+	{0x0CCA, 0x0CCD, propExtend},
+	{0x0CD5, 0x0CD6, propExtend},
+	{0x0CDC, 0x0CDE, propOLetter},
+	{0x0CE0, 0x0CE1, propOLetter},
+	{0x0CE2, 0x0CE3, propExtend},
+	{0x0CE6, 0x0CEF, propNumeric},
+	{0x0CF1, 0x0CF2, propOLetter},
+	{0x0CF3, 0x0CF3, propExtend},
+	{0x0D00, 0x0D03, propExtend},
+	{0x0D04, 0x0D0C, propOLetter},
+	{0x0D0E, 0x0D10, propOLetter},
+	{0x0D12, 0x0D3A, propOLetter},
+	{0x0D3B, 0x0D3C, propExtend},
+	{0x0D3D, 0x0D3D, propOLetter},
+	{0x0D3E, 0x0D44, propExtend},
+	{0x0D46, 0x0D48, propExtend},
+	{0x0D4A, 0x0D4D, propExtend},
+	{0x0D4E, 0x0D4E, propOLetter},
+	{0x0D54, 0x0D56, propOLetter},
+	{0x0D57, 0x0D57, propExtend},
+	{0x0D5F, 0x0D61, propOLetter},
+	{0x0D62, 0x0D63, propExtend},
+	{0x0D66, 0x0D6F, propNumeric},
+	{0x0D7A, 0x0D7F, propOLetter},
+	{0x0D81, 0x0D83, propExtend},
+	{0x0D85, 0x0D96, propOLetter},
+	{0x0D9A, 0x0DB1, propOLetter},
+	{0x0DB3, 0x0DBB, propOLetter},
+	{0x0DBD, 0x0DBD, propOLetter},
+	{0x0DC0, 0x0DC6, propOLetter},
+	{0x0DCA, 0x0DCA, propExtend},
+	{0x0DCF, 0x0DD4, propExtend},
+	{0x0DD6, 0x0DD6, propExtend},
+	{0x0DD8, 0x0DDF, propExtend},
+	{0x0DE6, 0x0DEF, propNumeric},
+	{0x0DF2, 0x0DF3, propExtend},
+	{0x0E01, 0x0E30, propOLetter},
+	{0x0E31, 0x0E31, propExtend},
+	{0x0E32, 0x0E33, propOLetter},
+	{0x0E34, 0x0E3A, propExtend},
+	{0x0E40, 0x0E46, propOLetter},
+	{0x0E47, 0x0E4E, propExtend},
+	{0x0E50, 0x0E59, propNumeric},
+	{0x0E81, 0x0E82, propOLetter},
+	{0x0E84, 0x0E84, propOLetter},
+	{0x0E86, 0x0E8A, propOLetter},
+	{0x0E8C, 0x0EA3, propOLetter},
+	{0x0EA5, 0x0EA5, propOLetter},
+	{0x0EA7, 0x0EB0, propOLetter},
+	{0x0EB1, 0x0EB1, propExtend},
+	{0x0EB2, 0x0EB3, propOLetter},
+	{0x0EB4, 0x0EBC, propExtend},
+	{0x0EBD, 0x0EBD, propOLetter},
+	{0x0EC0, 0x0EC4, propOLetter},
+	{0x0EC6, 0x0EC6, propOLetter},
+	{0x0EC8, 0x0ECE, propExtend},
+	{0x0ED0, 0x0ED9, propNumeric},
+	{0x0EDC, 0x0EDF, propOLetter},
+	{0x0F00, 0x0F00, propOLetter},
+	{0x0F18, 0x0F19, propExtend},
+	{0x0F20, 0x0F29, propNumeric},
+	{0x0F35, 0x0F35, propExtend},
+	{0x0F37, 0x0F37, propExtend},
+	{0x0F39, 0x0F39, propExtend},
+	{0x0F3A, 0x0F3D, propClose},
+	{0x0F3E, 0x0F3F, propExtend},
+	{0x0F40, 0x0F47, propOLetter},
+	{0x0F49, 0x0F6C, propOLetter},
+	{0x0F71, 0x0F84, propExtend},
+	{0x0F86, 0x0F87, propExtend},
+	{0x0F88, 0x0F8C, propOLetter},
+	{0x0F8D, 0x0F97, propExtend},
+	{0x0F99, 0x0FBC, propExtend},
+	{0x0FC6, 0x0FC6, propExtend},
+	{0x1000, 0x102A, propOLetter},
+	{0x102B, 0x103E, propExtend},
+	{0x103F, 0x103F, propOLetter},
+	{0x1040, 0x1049, propNumeric},
+	{0x104A, 0x104B, propSTerm},
+	{0x1050, 0x1055, propOLetter},
+	{0x1056, 0x1059, propExtend},
+	{0x105A, 0x105D, propOLetter},
+	{0x105E, 0x1060, propExtend},
+	{0x1061, 0x1061, propOLetter},
+	{0x1062, 0x1064, propExtend},
+	{0x1065, 0x1066, propOLetter},
+	{0x1067, 0x106D, propExtend},
+	{0x106E, 0x1070, propOLetter},
+	{0x1071, 0x1074, propExtend},
+	{0x1075, 0x1081, propOLetter},
+	{0x1082, 0x108D, propExtend},
+	{0x108E, 0x108E, propOLetter},
+	{0x108F, 0x108F, propExtend},
+	{0x1090, 0x1099, propNumeric},
+	{0x109A, 0x109D, propExtend},
+	{0x10A0, 0x10C5, propUpper},
+	{0x10C7, 0x10C7, propUpper},
+	{0x10CD, 0x10CD, propUpper},
+	{0x10D0, 0x10FA, propOLetter},
+	{0x10FC, 0x10FC, propLower},
+	{0x10FD, 0x1248, propOLetter},
+	{0x124A, 0x124D, propOLetter},
+	{0x1250, 0x1256, propOLetter},
+	{0x1258, 0x1258, propOLetter},
+	{0x125A, 0x125D, propOLetter},
+	{0x1260, 0x1288, propOLetter},
+	{0x128A, 0x128D, propOLetter},
+	{0x1290, 0x12B0, propOLetter},
+	{0x12B2, 0x12B5, propOLetter},
+	{0x12B8, 0x12BE, propOLetter},
+	{0x12C0, 0x12C0, propOLetter},
+	{0x12C2, 0x12C5, propOLetter},
+	{0x12C8, 0x12D6, propOLetter},
+	{0x12D8, 0x1310, propOLetter},
+	{0x1312, 0x1315, propOLetter},
+	{0x1318, 0x135A, propOLetter},
+	{0x135D, 0x135F, propExtend},
+	{0x1362, 0x1362, propSTerm},
+	{0x1367, 0x1368, propSTerm},
+	{0x1380, 0x138F, propOLetter},
+	{0x13A0, 0x13F5, propUpper},
+	{0x13F8, 0x13FD, propLower},
+	{0x1401, 0x166C, propOLetter},
+	{0x166E, 0x166E, propSTerm},
+	{0x166F, 0x167F, propOLetter},
+	{0x1680, 0x1680, propSp},
+	{0x1681, 0x169A, propOLetter},
+	{0x169B, 0x169C, propClose},
+	{0x16A0, 0x16EA, propOLetter},
+	{0x16EE, 0x16F8, propOLetter},
+	{0x1700, 0x1711, propOLetter},
+	{0x1712, 0x1715, propExtend},
+	{0x171F, 0x1731, propOLetter},
+	{0x1732, 0x1734, propExtend},
+	{0x1735, 0x1736, propSTerm},
+	{0x1740, 0x1751, propOLetter},
+	{0x1752, 0x1753, propExtend},
+	{0x1760, 0x176C, propOLetter},
+	{0x176E, 0x1770, propOLetter},
+	{0x1772, 0x1773, propExtend},
+	{0x1780, 0x17B3, propOLetter},
+	{0x17B4, 0x17D3, propExtend},
+	{0x17D4, 0x17D5, propSTerm},
+	{0x17D7, 0x17D7, propOLetter},
+	{0x17DC, 0x17DC, propOLetter},
+	{0x17DD, 0x17DD, propExtend},
+	{0x17E0, 0x17E9, propNumeric},
+	{0x1802, 0x1802, propSContinue},
+	{0x1803, 0x1803, propSTerm},
+	{0x1808, 0x1808, propSContinue},
+	{0x1809, 0x1809, propSTerm},
+	{0x180B, 0x180D, propExtend},
+	{0x180E, 0x180E, propFormat},
+	{0x180F, 0x180F, propExtend},
+	{0x1810, 0x1819, propNumeric},
+	{0x1820, 0x1878, propOLetter},
+	{0x1880, 0x1884, propOLetter},
+	{0x1885, 0x1886, propExtend},
+	{0x1887, 0x18A8, propOLetter},
+	{0x18A9, 0x18A9, propExtend},
+	{0x18AA, 0x18AA, propOLetter},
+	{0x18B0, 0x18F5, propOLetter},
+	{0x1900, 0x191E, propOLetter},
+	{0x1920, 0x192B, propExtend},
+	{0x1930, 0x193B, propExtend},
+	{0x1944, 0x1945, propSTerm},
+	{0x1946, 0x194F, propNumeric},
+	{0x1950, 0x196D, propOLetter},
+	{0x1970, 0x1974, propOLetter},
+	{0x1980, 0x19AB, propOLetter},
+	{0x19B0, 0x19C9, propOLetter},
+	{0x19D0, 0x19DA, propNumeric},
+	{0x1A00, 0x1A16, propOLetter},
+	{0x1A17, 0x1A1B, propExtend},
+	{0x1A20, 0x1A54, propOLetter},
+	{0x1A55, 0x1A5E, propExtend},
+	{0x1A60, 0x1A7C, propExtend},
+	{0x1A7F, 0x1A7F, propExtend},
+	{0x1A80, 0x1A89, propNumeric},
+	{0x1A90, 0x1A99, propNumeric},
+	{0x1AA7, 0x1AA7, propOLetter},
+	{0x1AA8, 0x1AAB, propSTerm},
+	{0x1AB0, 0x1ADD, propExtend},
+	{0x1AE0, 0x1AEB, propExtend},
+	{0x1B00, 0x1B04, propExtend},
+	{0x1B05, 0x1B33, propOLetter},
+	{0x1B34, 0x1B44, propExtend},
+	{0x1B45, 0x1B4C, propOLetter},
+	{0x1B4E, 0x1B4F, propSTerm},
+	{0x1B50, 0x1B59, propNumeric},
+	{0x1B5A, 0x1B5B, propSTerm},
+	{0x1B5E, 0x1B5F, propSTerm},
+	{0x1B6B, 0x1B73, propExtend},
+	{0x1B7D, 0x1B7F, propSTerm},
+	{0x1B80, 0x1B82, propExtend},
+	{0x1B83, 0x1BA0, propOLetter},
+	{0x1BA1, 0x1BAD, propExtend},
+	{0x1BAE, 0x1BAF, propOLetter},
+	{0x1BB0, 0x1BB9, propNumeric},
+	{0x1BBA, 0x1BE5, propOLetter},
+	{0x1BE6, 0x1BF3, propExtend},
+	{0x1C00, 0x1C23, propOLetter},
+	{0x1C24, 0x1C37, propExtend},
+	{0x1C3B, 0x1C3C, propSTerm},
+	{0x1C40, 0x1C49, propNumeric},
+	{0x1C4D, 0x1C4F, propOLetter},
+	{0x1C50, 0x1C59, propNumeric},
+	{0x1C5A, 0x1C7D, propOLetter},
+	{0x1C7E, 0x1C7F, propSTerm},
+	{0x1C80, 0x1C88, propLower},
+	{0x1C89, 0x1C89, propUpper},
+	{0x1C8A, 0x1C8A, propLower},
+	{0x1C90, 0x1CBA, propOLetter},
+	{0x1CBD, 0x1CBF, propOLetter},
+	{0x1CD0, 0x1CD2, propExtend},
+	{0x1CD4, 0x1CE8, propExtend},
+	{0x1CE9, 0x1CEC, propOLetter},
+	{0x1CED, 0x1CED, propExtend},
+	{0x1CEE, 0x1CF3, propOLetter},
+	{0x1CF4, 0x1CF4, propExtend},
+	{0x1CF5, 0x1CF6, propOLetter},
+	{0x1CF7, 0x1CF9, propExtend},
+	{0x1CFA, 0x1CFA, propOLetter},
+	{0x1D00, 0x1DBF, propLower},
+	{0x1DC0, 0x1DFF, propExtend},
+	{0x1E00, 0x1E00, propUpper},
+	{0x1E01, 0x1E01, propLower},
+	{0x1E02, 0x1E02, propUpper},
+	{0x1E03, 0x1E03, propLower},
+	{0x1E04, 0x1E04, propUpper},
+	{0x1E05, 0x1E05, propLower},
+	{0x1E06, 0x1E06, propUpper},
+	{0x1E07, 0x1E07, propLower},
+	{0x1E08, 0x1E08, propUpper},
+	{0x1E09, 0x1E09, propLower},
+	{0x1E0A, 0x1E0A, propUpper},
+	{0x1E0B, 0x1E0B, propLower},
+	{0x1E0C, 0x1E0C, propUpper},
+	{0x1E0D, 0x1E0D, propLower},
+	{0x1E0E, 0x1E0E, propUpper},
+	{0x1E0F, 0x1E0F, propLower},
+	{0x1E10, 0x1E10, propUpper},
+	{0x1E11, 0x1E11, propLower},
+	{0x1E12, 0x1E12, propUpper},
+	{0x1E13, 0x1E13, propLower},
+	{0x1E14, 0x1E14, propUpper},
+	{0x1E15, 0x1E15, propLower},
+	{0x1E16, 0x1E16, propUpper},
+	{0x1E17, 0x1E17, propLower},
+	{0x1E18, 0x1E18, propUpper},
+	{0x1E19, 0x1E19, propLower},
+	{0x1E1A, 0x1E1A, propUpper},
+	{0x1E1B, 0x1E1B, propLower},
+	{0x1E1C, 0x1E1C, propUpper},
+	{0x1E1D, 0x1E1D, propLower},
+	{0x1E1E, 0x1E1E, propUpper},
+	{0x1E1F, 0x1E1F, propLower},
+	{0x1E20, 0x1E20, propUpper},
+	{0x1E21, 0x1E21, propLower},
+	{0x1E22, 0x1E22, propUpper},
+	{0x1E23, 0x1E23, propLower},
+	{0x1E24, 0x1E24, propUpper},
+	{0x1E25, 0x1E25, propLower},
+	{0x1E26, 0x1E26, propUpper},
+	{0x1E27, 0x1E27, propLower},
+	{0x1E28, 0x1E28, propUpper},
+	{0x1E29, 0x1E29, propLower},
+	{0x1E2A, 0x1E2A, propUpper},
+	{0x1E2B, 0x1E2B, propLower},
+	{0x1E2C, 0x1E2C, propUpper},
+	{0x1E2D, 0x1E2D, propLower},
+	{0x1E2E, 0x1E2E, propUpper},
+	{0x1E2F, 0x1E2F, propLower},
+	{0x1E30, 0x1E30, propUpper},
+	{0x1E31, 0x1E31, propLower},
+	{0x1E32, 0x1E32, propUpper},
+	{0x1E33, 0x1E33, propLower},
+	{0x1E34, 0x1E34, propUpper},
+	{0x1E35, 0x1E35, propLower},
+	{0x1E36, 0x1E36, propUpper},
+	{0x1E37, 0x1E37, propLower},
+	{0x1E38, 0x1E38, propUpper},
+	{0x1E39, 0x1E39, propLower},
+	{0x1E3A, 0x1E3A, propUpper},
+	{0x1E3B, 0x1E3B, propLower},
+	{0x1E3C, 0x1E3C, propUpper},
+	{0x1E3D, 0x1E3D, propLower},
+	{0x1E3E, 0x1E3E, propUpper},
+	{0x1E3F, 0x1E3F, propLower},
+	{0x1E40, 0x1E40, propUpper},
+	{0x1E41, 0x1E41, propLower},
+	{0x1E42, 0x1E42, propUpper},
+	{0x1E43, 0x1E43, propLower},
+	{0x1E44, 0x1E44, propUpper},
+	{0x1E45, 0x1E45, propLower},
+	{0x1E46, 0x1E46, propUpper},
+	{0x1E47, 0x1E47, propLower},
+	{0x1E48, 0x1E48, propUpper},
+	{0x1E49, 0x1E49, propLower},
+	{0x1E4A, 0x1E4A, propUpper},
+	{0x1E4B, 0x1E4B, propLower},
+	{0x1E4C, 0x1E4C, propUpper},
+	{0x1E4D, 0x1E4D, propLower},
+	{0x1E4E, 0x1E4E, propUpper},
+	{0x1E4F, 0x1E4F, propLower},
+	{0x1E50, 0x1E50, propUpper},
+	{0x1E51, 0x1E51, propLower},
+	{0x1E52, 0x1E52, propUpper},
+	{0x1E53, 0x1E53, propLower},
+	{0x1E54, 0x1E54, propUpper},
+	{0x1E55, 0x1E55, propLower},
+	{0x1E56, 0x1E56, propUpper},
+	{0x1E57, 0x1E57, propLower},
+	{0x1E58, 0x1E58, propUpper},
+	{0x1E59, 0x1E59, propLower},
+	{0x1E5A, 0x1E5A, propUpper},
+	{0x1E5B, 0x1E5B, propLower},
+	{0x1E5C, 0x1E5C, propUpper},
+	{0x1E5D, 0x1E5D, propLower},
+	{0x1E5E, 0x1E5E, propUpper},
+	{0x1E5F, 0x1E5F, propLower},
+	{0x1E60, 0x1E60, propUpper},
+	{0x1E61, 0x1E61, propLower},
+	{0x1E62, 0x1E62, propUpper},
+	{0x1E63, 0x1E63, propLower},
+	{0x1E64, 0x1E64, propUpper},
+	{0x1E65, 0x1E65, propLower},
+	{0x1E66, 0x1E66, propUpper},
+	{0x1E67, 0x1E67, propLower},
+	{0x1E68, 0x1E68, propUpper},
+	{0x1E69, 0x1E69, propLower},
+	{0x1E6A, 0x1E6A, propUpper},
+	{0x1E6B, 0x1E6B, propLower},
+	{0x1E6C, 0x1E6C, propUpper},
+	{0x1E6D, 0x1E6D, propLower},
+	{0x1E6E, 0x1E6E, propUpper},
+	{0x1E6F, 0x1E6F, propLower},
+	{0x1E70, 0x1E70, propUpper},
+	{0x1E71, 0x1E71, propLower},
+	{0x1E72, 0x1E72, propUpper},
+	{0x1E73, 0x1E73, propLower},
+	{0x1E74, 0x1E74, propUpper},
+	{0x1E75, 0x1E75, propLower},
+	{0x1E76, 0x1E76, propUpper},
+	{0x1E77, 0x1E77, propLower},
+	{0x1E78, 0x1E78, propUpper},
+	{0x1E79, 0x1E79, propLower},
+	{0x1E7A, 0x1E7A, propUpper},
+	{0x1E7B, 0x1E7B, propLower},
+	{0x1E7C, 0x1E7C, propUpper},
+	{0x1E7D, 0x1E7D, propLower},
+	{0x1E7E, 0x1E7E, propUpper},
+	{0x1E7F, 0x1E7F, propLower},
+	{0x1E80, 0x1E80, propUpper},
+	{0x1E81, 0x1E81, propLower},
+	{0x1E82, 0x1E82, propUpper},
+	{0x1E83, 0x1E83, propLower},
+	{0x1E84, 0x1E84, propUpper},
+	{0x1E85, 0x1E85, propLower},
+	{0x1E86, 0x1E86, propUpper},
+	{0x1E87, 0x1E87, propLower},
+	{0x1E88, 0x1E88, propUpper},
+	{0x1E89, 0x1E89, propLower},
+	{0x1E8A, 0x1E8A, propUpper},
+	{0x1E8B, 0x1E8B, propLower},
+	{0x1E8C, 0x1E8C, propUpper},
+	{0x1E8D, 0x1E8D, propLower},
+	{0x1E8E, 0x1E8E, propUpper},
+	{0x1E8F, 0x1E8F, propLower},
+	{0x1E90, 0x1E90, propUpper},
+	{0x1E91, 0x1E91, propLower},
+	{0x1E92, 0x1E92, propUpper},
+	{0x1E93, 0x1E93, propLower},
+	{0x1E94, 0x1E94, propUpper},
+	{0x1E95, 0x1E9D, propLower},
+	{0x1E9E, 0x1E9E, propUpper},
+	{0x1E9F, 0x1E9F, propLower},
+	{0x1EA0, 0x1EA0, propUpper},
+	{0x1EA1, 0x1EA1, propLower},
+	{0x1EA2, 0x1EA2, propUpper},
+	{0x1EA3, 0x1EA3, propLower},
+	{0x1EA4, 0x1EA4, propUpper},
+	{0x1EA5, 0x1EA5, propLower},
+	{0x1EA6, 0x1EA6, propUpper},
+	{0x1EA7, 0x1EA7, propLower},
+	{0x1EA8, 0x1EA8, propUpper},
+	{0x1EA9, 0x1EA9, propLower},
+	{0x1EAA, 0x1EAA, propUpper},
+	{0x1EAB, 0x1EAB, propLower},
+	{0x1EAC, 0x1EAC, propUpper},
+	{0x1EAD, 0x1EAD, propLower},
+	{0x1EAE, 0x1EAE, propUpper},
+	{0x1EAF, 0x1EAF, propLower},
+	{0x1EB0, 0x1EB0, propUpper},
+	{0x1EB1, 0x1EB1, propLower},
+	{0x1EB2, 0x1EB2, propUpper},
+	{0x1EB3, 0x1EB3, propLower},
+	{0x1EB4, 0x1EB4, propUpper},
+	{0x1EB5, 0x1EB5, propLower},
+	{0x1EB6, 0x1EB6, propUpper},
+	{0x1EB7, 0x1EB7, propLower},
+	{0x1EB8, 0x1EB8, propUpper},
+	{0x1EB9, 0x1EB9, propLower},
+	{0x1EBA, 0x1EBA, propUpper},
+	{0x1EBB, 0x1EBB, propLower},
+	{0x1EBC, 0x1EBC, propUpper},
+	{0x1EBD, 0x1EBD, propLower},
+	{0x1EBE, 0x1EBE, propUpper},
+	{0x1EBF, 0x1EBF, propLower},
+	{0x1EC0, 0x1EC0, propUpper},
+	{0x1EC1, 0x1EC1, propLower},
+	{0x1EC2, 0x1EC2, propUpper},
+	{0x1EC3, 0x1EC3, propLower},
+	{0x1EC4, 0x1EC4, propUpper},
+	{0x1EC5, 0x1EC5, propLower},
+	{0x1EC6, 0x1EC6, propUpper},
+	{0x1EC7, 0x1EC7, propLower},
+	{0x1EC8, 0x1EC8, propUpper},
+	{0x1EC9, 0x1EC9, propLower},
+	{0x1ECA, 0x1ECA, propUpper},
+	{0x1ECB, 0x1ECB, propLower},
+	{0x1ECC, 0x1ECC, propUpper},
+	{0x1ECD, 0x1ECD, propLower},
+	{0x1ECE, 0x1ECE, propUpper},
+	{0x1ECF, 0x1ECF, propLower},
+	{0x1ED0, 0x1ED0, propUpper},
+	{0x1ED1, 0x1ED1, propLower},
+	{0x1ED2, 0x1ED2, propUpper},
+	{0x1ED3, 0x1ED3, propLower},
+	{0x1ED4, 0x1ED4, propUpper},
+	{0x1ED5, 0x1ED5, propLower},
+	{0x1ED6, 0x1ED6, propUpper},
+	{0x1ED7, 0x1ED7, propLower},
+	{0x1ED8, 0x1ED8, propUpper},
+	{0x1ED9, 0x1ED9, propLower},
+	{0x1EDA, 0x1EDA, propUpper},
+	{0x1EDB, 0x1EDB, propLower},
+	{0x1EDC, 0x1EDC, propUpper},
+	{0x1EDD, 0x1EDD, propLower},
+	{0x1EDE, 0x1EDE, propUpper},
+	{0x1EDF, 0x1EDF, propLower},
+	{0x1EE0, 0x1EE0, propUpper},
+	{0x1EE1, 0x1EE1, propLower},
+	{0x1EE2, 0x1EE2, propUpper},
+	{0x1EE3, 0x1EE3, propLower},
+	{0x1EE4, 0x1EE4, propUpper},
+	{0x1EE5, 0x1EE5, propLower},
+	{0x1EE6, 0x1EE6, propUpper},
+	{0x1EE7, 0x1EE7, propLower},
+	{0x1EE8, 0x1EE8, propUpper},
+	{0x1EE9, 0x1EE9, propLower},
+	{0x1EEA, 0x1EEA, propUpper},
+	{0x1EEB, 0x1EEB, propLower},
+	{0x1EEC, 0x1EEC, propUpper},
+	{0x1EED, 0x1EED, propLower},
+	{0x1EEE, 0x1EEE, propUpper},
+	{0x1EEF, 0x1EEF, propLower},
+	{0x1EF0, 0x1EF0, propUpper},
+	{0x1EF1, 0x1EF1, propLower},
+	{0x1EF2, 0x1EF2, propUpper},
+	{0x1EF3, 0x1EF3, propLower},
+	{0x1EF4, 0x1EF4, propUpper},
+	{0x1EF5, 0x1EF5, propLower},
+	{0x1EF6, 0x1EF6, propUpper},
+	{0x1EF7, 0x1EF7, propLower},
+	{0x1EF8, 0x1EF8, propUpper},
+	{0x1EF9, 0x1EF9, propLower},
+	{0x1EFA, 0x1EFA, propUpper},
+	{0x1EFB, 0x1EFB, propLower},
+	{0x1EFC, 0x1EFC, propUpper},
+	{0x1EFD, 0x1EFD, propLower},
+	{0x1EFE, 0x1EFE, propUpper},
+	{0x1EFF, 0x1F07, propLower},
+	{0x1F08, 0x1F0F, propUpper},
+	{0x1F10, 0x1F15, propLower},
+	{0x1F18, 0x1F1D, propUpper},
+	{0x1F20, 0x1F27, propLower},
+	{0x1F28, 0x1F2F, propUpper},
+	{0x1F30, 0x1F37, propLower},
+	{0x1F38, 0x1F3F, propUpper},
+	{0x1F40, 0x1F45, propLower},
+	{0x1F48, 0x1F4D, propUpper},
+	{0x1F50, 0x1F57, propLower},
+	{0x1F59, 0x1F59, propUpper},
+	{0x1F5B, 0x1F5B, propUpper},
+	{0x1F5D, 0x1F5D, propUpper},
+	{0x1F5F, 0x1F5F, propUpper},
+	{0x1F60, 0x1F67, propLower},
+	{0x1F68, 0x1F6F, propUpper},
+	{0x1F70, 0x1F7D, propLower},
+	{0x1F80, 0x1F87, propLower},
+	{0x1F88, 0x1F8F, propUpper},
+	{0x1F90, 0x1F97, propLower},
+	{0x1F98, 0x1F9F, propUpper},
+	{0x1FA0, 0x1FA7, propLower},
+	{0x1FA8, 0x1FAF, propUpper},
+	{0x1FB0, 0x1FB4, propLower},
+	{0x1FB6, 0x1FB7, propLower},
+	{0x1FB8, 0x1FBC, propUpper},
+	{0x1FBE, 0x1FBE, propLower},
+	{0x1FC2, 0x1FC4, propLower},
+	{0x1FC6, 0x1FC7, propLower},
+	{0x1FC8, 0x1FCC, propUpper},
+	{0x1FD0, 0x1FD3, propLower},
+	{0x1FD6, 0x1FD7, propLower},
+	{0x1FD8, 0x1FDB, propUpper},
+	{0x1FE0, 0x1FE7, propLower},
+	{0x1FE8, 0x1FEC, propUpper},
+	{0x1FF2, 0x1FF4, propLower},
+	{0x1FF6, 0x1FF7, propLower},
+	{0x1FF8, 0x1FFC, propUpper},
+	{0x2000, 0x200A, propSp},
+	{0x200B, 0x200B, propFormat},
+	{0x200C, 0x200D, propExtend},
+	{0x200E, 0x200F, propFormat},
+	{0x2013, 0x2014, propSContinue},
+	{0x2018, 0x201F, propClose},
+	{0x2024, 0x2024, propATerm},
+	{0x2028, 0x2029, propSep},
+	{0x202A, 0x202E, propFormat},
+	{0x202F, 0x202F, propSp},
+	{0x2039, 0x203A, propClose},
+	{0x203C, 0x203D, propSTerm},
+	{0x2045, 0x2046, propClose},
+	{0x2047, 0x2049, propSTerm},
+	{0x205F, 0x205F, propSp},
+	{0x2060, 0x2064, propFormat},
+	{0x2066, 0x206F, propFormat},
+	{0x2071, 0x2071, propLower},
+	{0x207D, 0x207E, propClose},
+	{0x207F, 0x207F, propLower},
+	{0x208D, 0x208E, propClose},
+	{0x2090, 0x209C, propLower},
+	{0x20D0, 0x20F0, propExtend},
+	{0x2102, 0x2102, propUpper},
+	{0x2107, 0x2107, propUpper},
+	{0x210A, 0x210A, propLower},
+	{0x210B, 0x210D, propUpper},
+	{0x210E, 0x210F, propLower},
+	{0x2110, 0x2112, propUpper},
+	{0x2113, 0x2113, propLower},
+	{0x2115, 0x2115, propUpper},
+	{0x2119, 0x211D, propUpper},
+	{0x2124, 0x2124, propUpper},
+	{0x2126, 0x2126, propUpper},
+	{0x2128, 0x2128, propUpper},
+	{0x212A, 0x212D, propUpper},
+	{0x212F, 0x212F, propLower},
+	{0x2130, 0x2133, propUpper},
+	{0x2134, 0x2134, propLower},
+	{0x2135, 0x2138, propOLetter},
+	{0x2139, 0x2139, propLower},
+	{0x213C, 0x213D, propLower},
+	{0x213E, 0x213F, propUpper},
+	{0x2145, 0x2145, propUpper},
+	{0x2146, 0x2149, propLower},
+	{0x214E, 0x214E, propLower},
+	{0x2160, 0x216F, propUpper},
+	{0x2170, 0x217F, propLower},
+	{0x2180, 0x2182, propOLetter},
+	{0x2183, 0x2183, propUpper},
+	{0x2184, 0x2184, propLower},
+	{0x2185, 0x2188, propOLetter},
+	{0x2308, 0x230B, propClose},
+	{0x2329, 0x232A, propClose},
+	{0x24B6, 0x24CF, propUpper},
+	{0x24D0, 0x24E9, propLower},
+	{0x275B, 0x2760, propClose},
+	{0x2768, 0x2775, propClose},
+	{0x27C5, 0x27C6, propClose},
+	{0x27E6, 0x27EF, propClose},
+	{0x2983, 0x2998, propClose},
+	{0x29D8, 0x29DB, propClose},
+	{0x29FC, 0x29FD, propClose},
+	{0x2C00, 0x2C2F, propUpper},
+	{0x2C30, 0x2C5F, propLower},
+	{0x2C60, 0x2C60, propUpper},
+	{0x2C61, 0x2C61, propLower},
+	{0x2C62, 0x2C64, propUpper},
+	{0x2C65, 0x2C66, propLower},
+	{0x2C67, 0x2C67, propUpper},
+	{0x2C68, 0x2C68, propLower},
+	{0x2C69, 0x2C69, propUpper},
+	{0x2C6A, 0x2C6A, propLower},
+	{0x2C6B, 0x2C6B, propUpper},
+	{0x2C6C, 0x2C6C, propLower},
+	{0x2C6D, 0x2C70, propUpper},
+	{0x2C71, 0x2C71, propLower},
+	{0x2C72, 0x2C72, propUpper},
+	{0x2C73, 0x2C74, propLower},
+	{0x2C75, 0x2C75, propUpper},
+	{0x2C76, 0x2C7D, propLower},
+	{0x2C7E, 0x2C80, propUpper},
+	{0x2C81, 0x2C81, propLower},
+	{0x2C82, 0x2C82, propUpper},
+	{0x2C83, 0x2C83, propLower},
+	{0x2C84, 0x2C84, propUpper},
+	{0x2C85, 0x2C85, propLower},
+	{0x2C86, 0x2C86, propUpper},
+	{0x2C87, 0x2C87, propLower},
+	{0x2C88, 0x2C88, propUpper},
+	{0x2C89, 0x2C89, propLower},
+	{0x2C8A, 0x2C8A, propUpper},
+	{0x2C8B, 0x2C8B, propLower},
+	{0x2C8C, 0x2C8C, propUpper},
+	{0x2C8D, 0x2C8D, propLower},
+	{0x2C8E, 0x2C8E, propUpper},
+	{0x2C8F, 0x2C8F, propLower},
+	{0x2C90, 0x2C90, propUpper},
+	{0x2C91, 0x2C91, propLower},
+	{0x2C92, 0x2C92, propUpper},
+	{0x2C93, 0x2C93, propLower},
+	{0x2C94, 0x2C94, propUpper},
+	{0x2C95, 0x2C95, propLower},
+	{0x2C96, 0x2C96, propUpper},
+	{0x2C97, 0x2C97, propLower},
+	{0x2C98, 0x2C98, propUpper},
+	{0x2C99, 0x2C99, propLower},
+	{0x2C9A, 0x2C9A, propUpper},
+	{0x2C9B, 0x2C9B, propLower},
+	{0x2C9C, 0x2C9C, propUpper},
+	{0x2C9D, 0x2C9D, propLower},
+	{0x2C9E, 0x2C9E, propUpper},
+	{0x2C9F, 0x2C9F, propLower},
+	{0x2CA0, 0x2CA0, propUpper},
+	{0x2CA1, 0x2CA1, propLower},
+	{0x2CA2, 0x2CA2, propUpper},
+	{0x2CA3, 0x2CA3, propLower},
+	{0x2CA4, 0x2CA4, propUpper},
+	{0x2CA5, 0x2CA5, propLower},
+	{0x2CA6, 0x2CA6, propUpper},
+	{0x2CA7, 0x2CA7, propLower},
+	{0x2CA8, 0x2CA8, propUpper},
+	{0x2CA9, 0x2CA9, propLower},
+	{0x2CAA, 0x2CAA, propUpper},
+	{0x2CAB, 0x2CAB, propLower},
+	{0x2CAC, 0x2CAC, propUpper},
+	{0x2CAD, 0x2CAD, propLower},
+	{0x2CAE, 0x2CAE, propUpper},
+	{0x2CAF, 0x2CAF, propLower},
+	{0x2CB0, 0x2CB0, propUpper},
+	{0x2CB1, 0x2CB1, propLower},
+	{0x2CB2, 0x2CB2, propUpper},
+	{0x2CB3, 0x2CB3, propLower},
+	{0x2CB4, 0x2CB4, propUpper},
+	{0x2CB5, 0x2CB5, propLower},
+	{0x2CB6, 0x2CB6, propUpper},
+	{0x2CB7, 0x2CB7, propLower},
+	{0x2CB8, 0x2CB8, propUpper},
+	{0x2CB9, 0x2CB9, propLower},
+	{0x2CBA, 0x2CBA, propUpper},
+	{0x2CBB, 0x2CBB, propLower},
+	{0x2CBC, 0x2CBC, propUpper},
+	{0x2CBD, 0x2CBD, propLower},
+	{0x2CBE, 0x2CBE, propUpper},
+	{0x2CBF, 0x2CBF, propLower},
+	{0x2CC0, 0x2CC0, propUpper},
+	{0x2CC1, 0x2CC1, propLower},
+	{0x2CC2, 0x2CC2, propUpper},
+	{0x2CC3, 0x2CC3, propLower},
+	{0x2CC4, 0x2CC4, propUpper},
+	{0x2CC5, 0x2CC5, propLower},
+	{0x2CC6, 0x2CC6, propUpper},
+	{0x2CC7, 0x2CC7, propLower},
+	{0x2CC8, 0x2CC8, propUpper},
+	{0x2CC9, 0x2CC9, propLower},
+	{0x2CCA, 0x2CCA, propUpper},
+	{0x2CCB, 0x2CCB, propLower},
+	{0x2CCC, 0x2CCC, propUpper},
+	{0x2CCD, 0x2CCD, propLower},
+	{0x2CCE, 0x2CCE, propUpper},
+	{0x2CCF, 0x2CCF, propLower},
+	{0x2CD0, 0x2CD0, propUpper},
+	{0x2CD1, 0x2CD1, propLower},
+	{0x2CD2, 0x2CD2, propUpper},
+	{0x2CD3, 0x2CD3, propLower},
+	{0x2CD4, 0x2CD4, propUpper},
+	{0x2CD5, 0x2CD5, propLower},
+	{0x2CD6, 0x2CD6, propUpper},
+	{0x2CD7, 0x2CD7, propLower},
+	{0x2CD8, 0x2CD8, propUpper},
+	{0x2CD9, 0x2CD9, propLower},
+	{0x2CDA, 0x2CDA, propUpper},
+	{0x2CDB, 0x2CDB, propLower},
+	{0x2CDC, 0x2CDC, propUpper},
+	{0x2CDD, 0x2CDD, propLower},
+	{0x2CDE, 0x2CDE, propUpper},
+	{0x2CDF, 0x2CDF, propLower},
+	{0x2CE0, 0x2CE0, propUpper},
+	{0x2CE1, 0x2CE1, propLower},
+	{0x2CE2, 0x2CE2, propUpper},
+	{0x2CE3, 0x2CE4, propLower},
+	{0x2CEB, 0x2CEB, propUpper},
+	{0x2CEC, 0x2CEC, propLower},
+	{0x2CED, 0x2CED, propUpper},
+	{0x2CEE, 0x2CEE, propLower},
+	{0x2CEF, 0x2CF1, propExtend},
+	{0x2CF2, 0x2CF2, propUpper},
+	{0x2CF3, 0x2CF3, propLower},
+	{0x2CF9, 0x2CFB, propSTerm},
+	{0x2D00, 0x2D25, propLower},
+	{0x2D27, 0x2D27, propLower},
+	{0x2D2D, 0x2D2D, propLower},
+	{0x2D30, 0x2D67, propOLetter},
+	{0x2D6F, 0x2D6F, propOLetter},
+	{0x2D7F, 0x2D7F, propExtend},
+	{0x2D80, 0x2D96, propOLetter},
+	{0x2DA0, 0x2DA6, propOLetter},
+	{0x2DA8, 0x2DAE, propOLetter},
+	{0x2DB0, 0x2DB6, propOLetter},
+	{0x2DB8, 0x2DBE, propOLetter},
+	{0x2DC0, 0x2DC6, propOLetter},
+	{0x2DC8, 0x2DCE, propOLetter},
+	{0x2DD0, 0x2DD6, propOLetter},
+	{0x2DD8, 0x2DDE, propOLetter},
+	{0x2DE0, 0x2DFF, propExtend},
+	{0x2E00, 0x2E0D, propClose},
+	{0x2E1C, 0x2E1D, propClose},
+	{0x2E20, 0x2E29, propClose},
+	{0x2E2E, 0x2E2E, propSTerm},
+	{0x2E2F, 0x2E2F, propOLetter},
+	{0x2E3C, 0x2E3C, propSTerm},
+	{0x2E42, 0x2E42, propClose},
+	{0x2E53, 0x2E54, propSTerm},
+	{0x2E55, 0x2E5C, propClose},
+	{0x3000, 0x3000, propSp},
+	{0x3001, 0x3001, propSContinue},
+	{0x3002, 0x3002, propSTerm},
+	{0x3005, 0x3007, propOLetter},
+	{0x3008, 0x3011, propClose},
+	{0x3014, 0x301B, propClose},
+	{0x301D, 0x301F, propClose},
+	{0x3021, 0x3029, propOLetter},
+	{0x302A, 0x302F, propExtend},
+	{0x3031, 0x3035, propOLetter},
+	{0x3038, 0x303C, propOLetter},
+	{0x3041, 0x3096, propOLetter},
+	{0x3099, 0x309A, propExtend},
+	{0x309D, 0x309F, propOLetter},
+	{0x30A1, 0x30FA, propOLetter},
+	{0x30FC, 0x30FF, propOLetter},
+	{0x3105, 0x312F, propOLetter},
+	{0x3131, 0x318E, propOLetter},
+	{0x31A0, 0x31BF, propOLetter},
+	{0x31F0, 0x31FF, propOLetter},
+	{0x3400, 0x4DBF, propOLetter},
+	{0x4E00, 0xA48C, propOLetter},
+	{0xA4D0, 0xA4FD, propOLetter},
+	{0xA4FF, 0xA4FF, propSTerm},
+	{0xA500, 0xA60C, propOLetter},
+	{0xA60E, 0xA60F, propSTerm},
+	{0xA610, 0xA61F, propOLetter},
+	{0xA620, 0xA629, propNumeric},
+	{0xA62A, 0xA62B, propOLetter},
+	{0xA640, 0xA640, propUpper},
+	{0xA641, 0xA641, propLower},
+	{0xA642, 0xA642, propUpper},
+	{0xA643, 0xA643, propLower},
+	{0xA644, 0xA644, propUpper},
+	{0xA645, 0xA645, propLower},
+	{0xA646, 0xA646, propUpper},
+	{0xA647, 0xA647, propLower},
+	{0xA648, 0xA648, propUpper},
+	{0xA649, 0xA649, propLower},
+	{0xA64A, 0xA64A, propUpper},
+	{0xA64B, 0xA64B, propLower},
+	{0xA64C, 0xA64C, propUpper},
+	{0xA64D, 0xA64D, propLower},
+	{0xA64E, 0xA64E, propUpper},
+	{0xA64F, 0xA64F, propLower},
+	{0xA650, 0xA650, propUpper},
+	{0xA651, 0xA651, propLower},
+	{0xA652, 0xA652, propUpper},
+	{0xA653, 0xA653, propLower},
+	{0xA654, 0xA654, propUpper},
+	{0xA655, 0xA655, propLower},
+	{0xA656, 0xA656, propUpper},
+	{0xA657, 0xA657, propLower},
+	{0xA658, 0xA658, propUpper},
+	{0xA659, 0xA659, propLower},
+	{0xA65A, 0xA65A, propUpper},
+	{0xA65B, 0xA65B, propLower},
+	{0xA65C, 0xA65C, propUpper},
+	{0xA65D, 0xA65D, propLower},
+	{0xA65E, 0xA65E, propUpper},
+	{0xA65F, 0xA65F, propLower},
+	{0xA660, 0xA660, propUpper},
+	{0xA661, 0xA661, propLower},
+	{0xA662, 0xA662, propUpper},
+	{0xA663, 0xA663, propLower},
+	{0xA664, 0xA664, propUpper},
+	{0xA665, 0xA665, propLower},
+	{0xA666, 0xA666, propUpper},
+	{0xA667, 0xA667, propLower},
+	{0xA668, 0xA668, propUpper},
+	{0xA669, 0xA669, propLower},
+	{0xA66A, 0xA66A, propUpper},
+	{0xA66B, 0xA66B, propLower},
+	{0xA66C, 0xA66C, propUpper},
+	{0xA66D, 0xA66D, propLower},
+	{0xA66E, 0xA66E, propOLetter},
+	{0xA66F, 0xA672, propExtend},
+	{0xA674, 0xA67D, propExtend},
+	{0xA67F, 0xA67F, propOLetter},
+	{0xA680, 0xA680, propUpper},
+	{0xA681, 0xA681, propLower},
+	{0xA682, 0xA682, propUpper},
+	{0xA683, 0xA683, propLower},
+	{0xA684, 0xA684, propUpper},
+	{0xA685, 0xA685, propLower},
+	{0xA686, 0xA686, propUpper},
+	{0xA687, 0xA687, propLower},
+	{0xA688, 0xA688, propUpper},
+	{0xA689, 0xA689, propLower},
+	{0xA68A, 0xA68A, propUpper},
+	{0xA68B, 0xA68B, propLower},
+	{0xA68C, 0xA68C, propUpper},
+	{0xA68D, 0xA68D, propLower},
+	{0xA68E, 0xA68E, propUpper},
+	{0xA68F, 0xA68F, propLower},
+	{0xA690, 0xA690, propUpper},
+	{0xA691, 0xA691, propLower},
+	{0xA692, 0xA692, propUpper},
+	{0xA693, 0xA693, propLower},
+	{0xA694, 0xA694, propUpper},
+	{0xA695, 0xA695, propLower},
+	{0xA696, 0xA696, propUpper},
+	{0xA697, 0xA697, propLower},
+	{0xA698, 0xA698, propUpper},
+	{0xA699, 0xA699, propLower},
+	{0xA69A, 0xA69A, propUpper},
+	{0xA69B, 0xA69D, propLower},
+	{0xA69E, 0xA69F, propExtend},
+	{0xA6A0, 0xA6EF, propOLetter},
+	{0xA6F0, 0xA6F1, propExtend},
+	{0xA6F3, 0xA6F3, propSTerm},
+	{0xA6F7, 0xA6F7, propSTerm},
+	{0xA717, 0xA71F, propOLetter},
+	{0xA722, 0xA722, propUpper},
+	{0xA723, 0xA723, propLower},
+	{0xA724, 0xA724, propUpper},
+	{0xA725, 0xA725, propLower},
+	{0xA726, 0xA726, propUpper},
+	{0xA727, 0xA727, propLower},
+	{0xA728, 0xA728, propUpper},
+	{0xA729, 0xA729, propLower},
+	{0xA72A, 0xA72A, propUpper},
+	{0xA72B, 0xA72B, propLower},
+	{0xA72C, 0xA72C, propUpper},
+	{0xA72D, 0xA72D, propLower},
+	{0xA72E, 0xA72E, propUpper},
+	{0xA72F, 0xA731, propLower},
+	{0xA732, 0xA732, propUpper},
+	{0xA733, 0xA733, propLower},
+	{0xA734, 0xA734, propUpper},
+	{0xA735, 0xA735, propLower},
+	{0xA736, 0xA736, propUpper},
+	{0xA737, 0xA737, propLower},
+	{0xA738, 0xA738, propUpper},
+	{0xA739, 0xA739, propLower},
+	{0xA73A, 0xA73A, propUpper},
+	{0xA73B, 0xA73B, propLower},
+	{0xA73C, 0xA73C, propUpper},
+	{0xA73D, 0xA73D, propLower},
+	{0xA73E, 0xA73E, propUpper},
+	{0xA73F, 0xA73F, propLower},
+	{0xA740, 0xA740, propUpper},
+	{0xA741, 0xA741, propLower},
+	{0xA742, 0xA742, propUpper},
+	{0xA743, 0xA743, propLower},
+	{0xA744, 0xA744, propUpper},
+	{0xA745, 0xA745, propLower},
+	{0xA746, 0xA746, propUpper},
+	{0xA747, 0xA747, propLower},
+	{0xA748, 0xA748, propUpper},
+	{0xA749, 0xA749, propLower},
+	{0xA74A, 0xA74A, propUpper},
+	{0xA74B, 0xA74B, propLower},
+	{0xA74C, 0xA74C, propUpper},
+	{0xA74D, 0xA74D, propLower},
+	{0xA74E, 0xA74E, propUpper},
+	{0xA74F, 0xA74F, propLower},
+	{0xA750, 0xA750, propUpper},
+	{0xA751, 0xA751, propLower},
+	{0xA752, 0xA752, propUpper},
+	{0xA753, 0xA753, propLower},
+	{0xA754, 0xA754, propUpper},
+	{0xA755, 0xA755, propLower},
+	{0xA756, 0xA756, propUpper},
+	{0xA757, 0xA757, propLower},
+	{0xA758, 0xA758, propUpper},
+	{0xA759, 0xA759, propLower},
+	{0xA75A, 0xA75A, propUpper},
+	{0xA75B, 0xA75B, propLower},
+	{0xA75C, 0xA75C, propUpper},
+	{0xA75D, 0xA75D, propLower},
+	{0xA75E, 0xA75E, propUpper},
+	{0xA75F, 0xA75F, propLower},
+	{0xA760, 0xA760, propUpper},
+	{0xA761, 0xA761, propLower},
+	{0xA762, 0xA762, propUpper},
+	{0xA763, 0xA763, propLower},
+	{0xA764, 0xA764, propUpper},
+	{0xA765, 0xA765, propLower},
+	{0xA766, 0xA766, propUpper},
+	{0xA767, 0xA767, propLower},
+	{0xA768, 0xA768, propUpper},
+	{0xA769, 0xA769, propLower},
+	{0xA76A, 0xA76A, propUpper},
+	{0xA76B, 0xA76B, propLower},
+	{0xA76C, 0xA76C, propUpper},
+	{0xA76D, 0xA76D, propLower},
+	{0xA76E, 0xA76E, propUpper},
+	{0xA76F, 0xA778, propLower},
+	{0xA779, 0xA779, propUpper},
+	{0xA77A, 0xA77A, propLower},
+	{0xA77B, 0xA77B, propUpper},
+	{0xA77C, 0xA77C, propLower},
+	{0xA77D, 0xA77E, propUpper},
+	{0xA77F, 0xA77F, propLower},
+	{0xA780, 0xA780, propUpper},
+	{0xA781, 0xA781, propLower},
+	{0xA782, 0xA782, propUpper},
+	{0xA783, 0xA783, propLower},
+	{0xA784, 0xA784, propUpper},
+	{0xA785, 0xA785, propLower},
+	{0xA786, 0xA786, propUpper},
+	{0xA787, 0xA787, propLower},
+	{0xA788, 0xA788, propOLetter},
+	{0xA78B, 0xA78B, propUpper},
+	{0xA78C, 0xA78C, propLower},
+	{0xA78D, 0xA78D, propUpper},
+	{0xA78E, 0xA78E, propLower},
+	{0xA78F, 0xA78F, propOLetter},
+	{0xA790, 0xA790, propUpper},
+	{0xA791, 0xA791, propLower},
+	{0xA792, 0xA792, propUpper},
+	{0xA793, 0xA795, propLower},
+	{0xA796, 0xA796, propUpper},
+	{0xA797, 0xA797, propLower},
+	{0xA798, 0xA798, propUpper},
+	{0xA799, 0xA799, propLower},
+	{0xA79A, 0xA79A, propUpper},
+	{0xA79B, 0xA79B, propLower},
+	{0xA79C, 0xA79C, propUpper},
+	{0xA79D, 0xA79D, propLower},
+	{0xA79E, 0xA79E, propUpper},
+	{0xA79F, 0xA79F, propLower},
+	{0xA7A0, 0xA7A0, propUpper},
+	{0xA7A1, 0xA7A1, propLower},
+	{0xA7A2, 0xA7A2, propUpper},
+	{0xA7A3, 0xA7A3, propLower},
+	{0xA7A4, 0xA7A4, propUpper},
+	{0xA7A5, 0xA7A5, propLower},
+	{0xA7A6, 0xA7A6, propUpper},
+	{0xA7A7, 0xA7A7, propLower},
+	{0xA7A8, 0xA7A8, propUpper},
+	{0xA7A9, 0xA7A9, propLower},
+	{0xA7AA, 0xA7AE, propUpper},
+	{0xA7AF, 0xA7AF, propLower},
+	{0xA7B0, 0xA7B4, propUpper},
+	{0xA7B5, 0xA7B5, propLower},
+	{0xA7B6, 0xA7B6, propUpper},
+	{0xA7B7, 0xA7B7, propLower},
+	{0xA7B8, 0xA7B8, propUpper},
+	{0xA7B9, 0xA7B9, propLower},
+	{0xA7BA, 0xA7BA, propUpper},
+	{0xA7BB, 0xA7BB, propLower},
+	{0xA7BC, 0xA7BC, propUpper},
+	{0xA7BD, 0xA7BD, propLower},
+	{0xA7BE, 0xA7BE, propUpper},
+	{0xA7BF, 0xA7BF, propLower},
+	{0xA7C0, 0xA7C0, propUpper},
+	{0xA7C1, 0xA7C1, propLower},
+	{0xA7C2, 0xA7C2, propUpper},
+	{0xA7C3, 0xA7C3, propLower},
+	{0xA7C4, 0xA7C7, propUpper},
+	{0xA7C8, 0xA7C8, propLower},
+	{0xA7C9, 0xA7C9, propUpper},
+	{0xA7CA, 0xA7CA, propLower},
+	{0xA7CB, 0xA7CC, propUpper},
+	{0xA7CD, 0xA7CD, propLower},
+	{0xA7CE, 0xA7CE, propUpper},
+	{0xA7CF, 0xA7CF, propLower},
+	{0xA7D0, 0xA7D0, propUpper},
+	{0xA7D1, 0xA7D1, propLower},
+	{0xA7D2, 0xA7D2, propUpper},
+	{0xA7D3, 0xA7D3, propLower},
+	{0xA7D4, 0xA7D4, propUpper},
+	{0xA7D5, 0xA7D5, propLower},
+	{0xA7D6, 0xA7D6, propUpper},
+	{0xA7D7, 0xA7D7, propLower},
+	{0xA7D8, 0xA7D8, propUpper},
+	{0xA7D9, 0xA7D9, propLower},
+	{0xA7DA, 0xA7DA, propUpper},
+	{0xA7DB, 0xA7DB, propLower},
+	{0xA7DC, 0xA7DC, propUpper},
+	{0xA7F1, 0xA7F4, propLower},
+	{0xA7F5, 0xA7F5, propUpper},
+	{0xA7F6, 0xA7F6, propLower},
+	{0xA7F7, 0xA7F7, propOLetter},
+	{0xA7F8, 0xA7FA, propLower},
+	{0xA7FB, 0xA801, propOLetter},
+	{0xA802, 0xA802, propExtend},
+	{0xA803, 0xA805, propOLetter},
+	{0xA806, 0xA806, propExtend},
+	{0xA807, 0xA80A, propOLetter},
+	{0xA80B, 0xA80B, propExtend},
+	{0xA80C, 0xA822, propOLetter},
+	{0xA823, 0xA827, propExtend},
+	{0xA82C, 0xA82C, propExtend},
+	{0xA840, 0xA873, propOLetter},
+	{0xA876, 0xA877, propSTerm},
+	{0xA880, 0xA881, propExtend},
+	{0xA882, 0xA8B3, propOLetter},
+	{0xA8B4, 0xA8C5, propExtend},
+	{0xA8CE, 0xA8CF, propSTerm},
+	{0xA8D0, 0xA8D9, propNumeric},
+	{0xA8E0, 0xA8F1, propExtend},
+	{0xA8F2, 0xA8F7, propOLetter},
+	{0xA8FB, 0xA8FB, propOLetter},
+	{0xA8FD, 0xA8FE, propOLetter},
+	{0xA8FF, 0xA8FF, propExtend},
+	{0xA900, 0xA909, propNumeric},
+	{0xA90A, 0xA925, propOLetter},
+	{0xA926, 0xA92D, propExtend},
+	{0xA92F, 0xA92F, propSTerm},
+	{0xA930, 0xA946, propOLetter},
+	{0xA947, 0xA953, propExtend},
+	{0xA960, 0xA97C, propOLetter},
+	{0xA980, 0xA983, propExtend},
+	{0xA984, 0xA9B2, propOLetter},
+	{0xA9B3, 0xA9C0, propExtend},
+	{0xA9C8, 0xA9C9, propSTerm},
+	{0xA9CF, 0xA9CF, propOLetter},
+	{0xA9D0, 0xA9D9, propNumeric},
+	{0xA9E0, 0xA9E4, propOLetter},
+	{0xA9E5, 0xA9E5, propExtend},
+	{0xA9E6, 0xA9EF, propOLetter},
+	{0xA9F0, 0xA9F9, propNumeric},
+	{0xA9FA, 0xA9FE, propOLetter},
+	{0xAA00, 0xAA28, propOLetter},
+	{0xAA29, 0xAA36, propExtend},
+	{0xAA40, 0xAA42, propOLetter},
+	{0xAA43, 0xAA43, propExtend},
+	{0xAA44, 0xAA4B, propOLetter},
+	{0xAA4C, 0xAA4D, propExtend},
+	{0xAA50, 0xAA59, propNumeric},
+	{0xAA5D, 0xAA5F, propSTerm},
+	{0xAA60, 0xAA76, propOLetter},
+	{0xAA7A, 0xAA7A, propOLetter},
+	{0xAA7B, 0xAA7D, propExtend},
+	{0xAA7E, 0xAAAF, propOLetter},
+	{0xAAB0, 0xAAB0, propExtend},
+	{0xAAB1, 0xAAB1, propOLetter},
+	{0xAAB2, 0xAAB4, propExtend},
+	{0xAAB5, 0xAAB6, propOLetter},
+	{0xAAB7, 0xAAB8, propExtend},
+	{0xAAB9, 0xAABD, propOLetter},
+	{0xAABE, 0xAABF, propExtend},
+	{0xAAC0, 0xAAC0, propOLetter},
+	{0xAAC1, 0xAAC1, propExtend},
+	{0xAAC2, 0xAAC2, propOLetter},
+	{0xAADB, 0xAADD, propOLetter},
+	{0xAAE0, 0xAAEA, propOLetter},
+	{0xAAEB, 0xAAEF, propExtend},
+	{0xAAF0, 0xAAF1, propSTerm},
+	{0xAAF2, 0xAAF4, propOLetter},
+	{0xAAF5, 0xAAF6, propExtend},
+	{0xAB01, 0xAB06, propOLetter},
+	{0xAB09, 0xAB0E, propOLetter},
+	{0xAB11, 0xAB16, propOLetter},
+	{0xAB20, 0xAB26, propOLetter},
+	{0xAB28, 0xAB2E, propOLetter},
+	{0xAB30, 0xAB5A, propLower},
+	{0xAB5C, 0xAB69, propLower},
+	{0xAB70, 0xABBF, propLower},
+	{0xABC0, 0xABE2, propOLetter},
+	{0xABE3, 0xABEA, propExtend},
+	{0xABEB, 0xABEB, propSTerm},
+	{0xABEC, 0xABED, propExtend},
+	{0xABF0, 0xABF9, propNumeric},
+	{0xAC00, 0xD7A3, propOLetter},
+	{0xD7B0, 0xD7C6, propOLetter},
+	{0xD7CB, 0xD7FB, propOLetter},
+	{0xF900, 0xFA6D, propOLetter},
+	{0xFA70, 0xFAD9, propOLetter},
+	{0xFB00, 0xFB06, propLower},
+	{0xFB13, 0xFB17, propLower},
+	{0xFB1D, 0xFB1D, propOLetter},
+	{0xFB1E, 0xFB1E, propExtend},
+	{0xFB1F, 0xFB28, propOLetter},
+	{0xFB2A, 0xFB36, propOLetter},
+	{0xFB38, 0xFB3C, propOLetter},
+	{0xFB3E, 0xFB3E, propOLetter},
+	{0xFB40, 0xFB41, propOLetter},
+	{0xFB43, 0xFB44, propOLetter},
+	{0xFB46, 0xFBB1, propOLetter},
+	{0xFBD3, 0xFD3D, propOLetter},
+	{0xFD3E, 0xFD3F, propClose},
+	{0xFD50, 0xFD8F, propOLetter},
+	{0xFD92, 0xFDC7, propOLetter},
+	{0xFDF0, 0xFDFB, propOLetter},
+	{0xFE00, 0xFE0F, propExtend},
+	{0xFE10, 0xFE11, propSContinue},
+	{0xFE12, 0xFE12, propSTerm},
+	{0xFE13, 0xFE14, propSContinue},
+	{0xFE15, 0xFE16, propSTerm},
+	{0xFE17, 0xFE18, propClose},
+	{0xFE20, 0xFE2F, propExtend},
+	{0xFE31, 0xFE32, propSContinue},
+	{0xFE35, 0xFE44, propClose},
+	{0xFE47, 0xFE48, propClose},
+	{0xFE50, 0xFE51, propSContinue},
+	{0xFE52, 0xFE52, propATerm},
+	{0xFE54, 0xFE55, propSContinue},
+	{0xFE56, 0xFE57, propSTerm},
+	{0xFE58, 0xFE58, propSContinue},
+	{0xFE59, 0xFE5E, propClose},
+	{0xFE63, 0xFE63, propSContinue},
+	{0xFE70, 0xFE74, propOLetter},
+	{0xFE76, 0xFEFC, propOLetter},
+	{0xFEFF, 0xFEFF, propFormat},
+	{0xFF01, 0xFF01, propSTerm},
+	{0xFF08, 0xFF09, propClose},
+	{0xFF0C, 0xFF0D, propSContinue},
+	{0xFF0E, 0xFF0E, propATerm},
+	{0xFF10, 0xFF19, propNumeric},
+	{0xFF1A, 0xFF1B, propSContinue},
+	{0xFF1F, 0xFF1F, propSTerm},
+	{0xFF21, 0xFF3A, propUpper},
+	{0xFF3B, 0xFF3B, propClose},
+	{0xFF3D, 0xFF3D, propClose},
+	{0xFF41, 0xFF5A, propLower},
+	{0xFF5B, 0xFF5B, propClose},
+	{0xFF5D, 0xFF5D, propClose},
+	{0xFF5F, 0xFF60, propClose},
+	{0xFF61, 0xFF61, propSTerm},
+	{0xFF62, 0xFF63, propClose},
+	{0xFF64, 0xFF64, propSContinue},
+	{0xFF66, 0xFF9D, propOLetter},
+	{0xFF9E, 0xFF9F, propExtend},
+	{0xFFA0, 0xFFBE, propOLetter},
+	{0xFFC2, 0xFFC7, propOLetter},
+	{0xFFCA, 0xFFCF, propOLetter},
+	{0xFFD2, 0xFFD7, propOLetter},
+	{0xFFDA, 0xFFDC, propOLetter},
+	{0xFFF9, 0xFFFB, propFormat},
+	{0x10000, 0x1000B, propOLetter},
+	{0x1000D, 0x10026, propOLetter},
+	{0x10028, 0x1003A, propOLetter},
+	{0x1003C, 0x1003D, propOLetter},
+	{0x1003F, 0x1004D, propOLetter},
+	{0x10050, 0x1005D, propOLetter},
+	{0x10080, 0x100FA, propOLetter},
+	{0x10140, 0x10174, propOLetter},
+	{0x101FD, 0x101FD, propExtend},
+	{0x10280, 0x1029C, propOLetter},
+	{0x102A0, 0x102D0, propOLetter},
+	{0x102E0, 0x102E0, propExtend},
+	{0x10300, 0x1031F, propOLetter},
+	{0x1032D, 0x1034A, propOLetter},
+	{0x10350, 0x10375, propOLetter},
+	{0x10376, 0x1037A, propExtend},
+	{0x10380, 0x1039D, propOLetter},
+	{0x103A0, 0x103C3, propOLetter},
+	{0x103C8, 0x103CF, propOLetter},
+	{0x103D1, 0x103D5, propOLetter},
+	{0x10400, 0x10427, propUpper},
+	{0x10428, 0x1044F, propLower},
+	{0x10450, 0x1049D, propOLetter},
+	{0x104A0, 0x104A9, propNumeric},
+	{0x104B0, 0x104D3, propUpper},
+	{0x104D8, 0x104FB, propLower},
+	{0x10500, 0x10527, propOLetter},
+	{0x10530, 0x10563, propOLetter},
+	{0x10570, 0x1057A, propUpper},
+	{0x1057C, 0x1058A, propUpper},
+	{0x1058C, 0x10592, propUpper},
+	{0x10594, 0x10595, propUpper},
+	{0x10597, 0x105A1, propLower},
+	{0x105A3, 0x105B1, propLower},
+	{0x105B3, 0x105B9, propLower},
+	{0x105BB, 0x105BC, propLower},
+	{0x105C0, 0x105F3, propOLetter},
+	{0x10600, 0x10736, propOLetter},
+	{0x10740, 0x10755, propOLetter},
+	{0x10760, 0x10767, propOLetter},
+	{0x10780, 0x10780, propLower},
+	{0x10781, 0x10782, propOLetter},
+	{0x10783, 0x10785, propLower},
+	{0x10787, 0x107B0, propLower},
+	{0x107B2, 0x107BA, propLower},
+	{0x10800, 0x10805, propOLetter},
+	{0x10808, 0x10808, propOLetter},
+	{0x1080A, 0x10835, propOLetter},
+	{0x10837, 0x10838, propOLetter},
+	{0x1083C, 0x1083C, propOLetter},
+	{0x1083F, 0x10855, propOLetter},
+	{0x10860, 0x10876, propOLetter},
+	{0x10880, 0x1089E, propOLetter},
+	{0x108E0, 0x108F2, propOLetter},
+	{0x108F4, 0x108F5, propOLetter},
+	{0x10900, 0x10915, propOLetter},
+	{0x10920, 0x10939, propOLetter},
+	{0x10940, 0x10959, propOLetter},
+	{0x10980, 0x109B7, propOLetter},
+	{0x109BE, 0x109BF, propOLetter},
+	{0x10A00, 0x10A00, propOLetter},
+	{0x10A01, 0x10A03, propExtend},
+	{0x10A05, 0x10A06, propExtend},
+	{0x10A0C, 0x10A0F, propExtend},
+	{0x10A10, 0x10A13, propOLetter},
+	{0x10A15, 0x10A17, propOLetter},
+	{0x10A19, 0x10A35, propOLetter},
+	{0x10A38, 0x10A3A, propExtend},
+	{0x10A3F, 0x10A3F, propExtend},
+	{0x10A56, 0x10A57, propSTerm},
+	{0x10A60, 0x10A7C, propOLetter},
+	{0x10A80, 0x10A9C, propOLetter},
+	{0x10AC0, 0x10AC7, propOLetter},
+	{0x10AC9, 0x10AE4, propOLetter},
+	{0x10AE5, 0x10AE6, propExtend},
+	{0x10B00, 0x10B35, propOLetter},
+	{0x10B40, 0x10B55, propOLetter},
+	{0x10B60, 0x10B72, propOLetter},
+	{0x10B80, 0x10B91, propOLetter},
+	{0x10C00, 0x10C48, propOLetter},
+	{0x10C80, 0x10CB2, propUpper},
+	{0x10CC0, 0x10CF2, propLower},
+	{0x10D00, 0x10D23, propOLetter},
+	{0x10D24, 0x10D27, propExtend},
+	{0x10D30, 0x10D39, propNumeric},
+	{0x10D40, 0x10D49, propNumeric},
+	{0x10D4A, 0x10D4F, propOLetter},
+	{0x10D50, 0x10D65, propUpper},
+	{0x10D69, 0x10D6D, propExtend},
+	{0x10D6F, 0x10D6F, propOLetter},
+	{0x10D70, 0x10D85, propLower},
+	{0x10E80, 0x10EA9, propOLetter},
+	{0x10EAB, 0x10EAC, propExtend},
+	{0x10EB0, 0x10EB1, propOLetter},
+	{0x10EC2, 0x10EC7, propOLetter},
+	{0x10EFA, 0x10EFF, propExtend},
+	{0x10F00, 0x10F1C, propOLetter},
+	{0x10F27, 0x10F27, propOLetter},
+	{0x10F30, 0x10F45, propOLetter},
+	{0x10F46, 0x10F50, propExtend},
+	{0x10F55, 0x10F59, propSTerm},
+	{0x10F70, 0x10F81, propOLetter},
+	{0x10F82, 0x10F85, propExtend},
+	{0x10F86, 0x10F89, propSTerm},
+	{0x10FB0, 0x10FC4, propOLetter},
+	{0x10FE0, 0x10FF6, propOLetter},
+	{0x11000, 0x11002, propExtend},
+	{0x11003, 0x11037, propOLetter},
+	{0x11038, 0x11046, propExtend},
+	{0x11047, 0x11048, propSTerm},
+	{0x11066, 0x1106F, propNumeric},
+	{0x11070, 0x11070, propExtend},
+	{0x11071, 0x11072, propOLetter},
+	{0x11073, 0x11074, propExtend},
+	{0x11075, 0x11075, propOLetter},
+	{0x1107F, 0x11082, propExtend},
+	{0x11083, 0x110AF, propOLetter},
+	{0x110B0, 0x110BA, propExtend},
+	{0x110BD, 0x110BD, propNumeric},
+	{0x110BE, 0x110C1, propSTerm},
+	{0x110C2, 0x110C2, propExtend},
+	{0x110CD, 0x110CD, propNumeric},
+	{0x110D0, 0x110E8, propOLetter},
+	{0x110F0, 0x110F9, propNumeric},
+	{0x11100, 0x11102, propExtend},
+	{0x11103, 0x11126, propOLetter},
+	{0x11127, 0x11134, propExtend},
+	{0x11136, 0x1113F, propNumeric},
+	{0x11141, 0x11143, propSTerm},
+	{0x11144, 0x11144, propOLetter},
+	{0x11145, 0x11146, propExtend},
+	{0x11147, 0x11147, propOLetter},
+	{0x11150, 0x11172, propOLetter},
+	{0x11173, 0x11173, propExtend},
+	{0x11176, 0x11176, propOLetter},
+	{0x11180, 0x11182, propExtend},
+	{0x11183, 0x111B2, propOLetter},
+	{0x111B3, 0x111C0, propExtend},
+	{0x111C1, 0x111C4, propOLetter},
+	{0x111C5, 0x111C6, propSTerm},
+	{0x111C9, 0x111CC, propExtend},
+	{0x111CD, 0x111CD, propSTerm},
+	{0x111CE, 0x111CF, propExtend},
+	{0x111D0, 0x111D9, propNumeric},
+	{0x111DA, 0x111DA, propOLetter},
+	{0x111DC, 0x111DC, propOLetter},
+	{0x111DE, 0x111DF, propSTerm},
+	{0x11200, 0x11211, propOLetter},
+	{0x11213, 0x1122B, propOLetter},
+	{0x1122C, 0x11237, propExtend},
+	{0x11238, 0x11239, propSTerm},
+	{0x1123B, 0x1123C, propSTerm},
+	{0x1123E, 0x1123E, propExtend},
+	{0x1123F, 0x11240, propOLetter},
+	{0x11241, 0x11241, propExtend},
+	{0x11280, 0x11286, propOLetter},
+	{0x11288, 0x11288, propOLetter},
+	{0x1128A, 0x1128D, propOLetter},
+	{0x1128F, 0x1129D, propOLetter},
+	{0x1129F, 0x112A8, propOLetter},
+	{0x112A9, 0x112A9, propSTerm},
+	{0x112B0, 0x112DE, propOLetter},
+	{0x112DF, 0x112EA, propExtend},
+	{0x112F0, 0x112F9, propNumeric},
+	{0x11300, 0x11303, propExtend},
+	{0x11305, 0x1130C, propOLetter},
+	{0x1130F, 0x11310, propOLetter},
+	{0x11313, 0x11328, propOLetter},
+	{0x1132A, 0x11330, propOLetter},
+	{0x11332, 0x11333, propOLetter},
+	{0x11335, 0x11339, propOLetter},
+	{0x1133B, 0x1133C, propExtend},
+	{0x1133D, 0x1133D, propOLetter},
+	{0x1133E, 0x11344, propExtend},
+	{0x11347, 0x11348, propExtend},
+	{0x1134B, 0x1134D, propExtend},
+	{0x11350, 0x11350, propOLetter},
+	{0x11357, 0x11357, propExtend},
+	{0x1135D, 0x11361, propOLetter},
+	{0x11362, 0x11363, propExtend},
+	{0x11366, 0x1136C, propExtend},
+	{0x11370, 0x11374, propExtend},
+	{0x11380, 0x11389, propOLetter},
+	{0x1138B, 0x1138B, propOLetter},
+	{0x1138E, 0x1138E, propOLetter},
+	{0x11390, 0x113B5, propOLetter},
+	{0x113B7, 0x113B7, propOLetter},
+	{0x113B8, 0x113C0, propExtend},
+	{0x113C2, 0x113C2, propExtend},
+	{0x113C5, 0x113C5, propExtend},
+	{0x113C7, 0x113CA, propExtend},
+	{0x113CC, 0x113D0, propExtend},
+	{0x113D1, 0x113D1, propOLetter},
+	{0x113D2, 0x113D2, propExtend},
+	{0x113D3, 0x113D3, propOLetter},
+	{0x113D4, 0x113D5, propSTerm},
+	{0x113E1, 0x113E2, propExtend},
+	{0x11400, 0x11434, propOLetter},
+	{0x11435, 0x11446, propExtend},
+	{0x11447, 0x1144A, propOLetter},
+	{0x1144B, 0x1144C, propSTerm},
+	{0x11450, 0x11459, propNumeric},
+	{0x1145E, 0x1145E, propExtend},
+	{0x1145F, 0x11461, propOLetter},
+	{0x11480, 0x114AF, propOLetter},
+	{0x114B0, 0x114C3, propExtend},
+	{0x114C4, 0x114C5, propOLetter},
+	{0x114C7, 0x114C7, propOLetter},
+	{0x114D0, 0x114D9, propNumeric},
+	{0x11580, 0x115AE, propOLetter},
+	{0x115AF, 0x115B5, propExtend},
+	{0x115B8, 0x115C0, propExtend},
+	{0x115C2, 0x115C3, propSTerm},
+	{0x115C9, 0x115D7, propSTerm},
+	{0x115D8, 0x115DB, propOLetter},
+	{0x115DC, 0x115DD, propExtend},
+	{0x11600, 0x1162F, propOLetter},
+	{0x11630, 0x11640, propExtend},
+	{0x11641, 0x11642, propSTerm},
+	{0x11644, 0x11644, propOLetter},
+	{0x11650, 0x11659, propNumeric},
+	{0x11680, 0x116AA, propOLetter},
+	{0x116AB, 0x116B7, propExtend},
+	{0x116B8, 0x116B8, propOLetter},
+	{0x116C0, 0x116C9, propNumeric},
+	{0x116D0, 0x116E3, propNumeric},
+	{0x11700, 0x1171A, propOLetter},
+	{0x1171D, 0x1172B, propExtend},
+	{0x11730, 0x11739, propNumeric},
+	{0x1173C, 0x1173E, propSTerm},
+	{0x11740, 0x11746, propOLetter},
+	{0x11800, 0x1182B, propOLetter},
+	{0x1182C, 0x1183A, propExtend},
+	{0x118A0, 0x118BF, propUpper},
+	{0x118C0, 0x118DF, propLower},
+	{0x118E0, 0x118E9, propNumeric},
+	{0x118FF, 0x11906, propOLetter},
+	{0x11909, 0x11909, propOLetter},
+	{0x1190C, 0x11913, propOLetter},
+	{0x11915, 0x11916, propOLetter},
+	{0x11918, 0x1192F, propOLetter},
+	{0x11930, 0x11935, propExtend},
+	{0x11937, 0x11938, propExtend},
+	{0x1193B, 0x1193E, propExtend},
+	{0x1193F, 0x1193F, propOLetter},
+	{0x11940, 0x11940, propExtend},
+	{0x11941, 0x11941, propOLetter},
+	{0x11942, 0x11943, propExtend},
+	{0x11944, 0x11944, propSTerm},
+	{0x11946, 0x11946, propSTerm},
+	{0x11950, 0x11959, propNumeric},
+	{0x119A0, 0x119A7, propOLetter},
+	{0x119AA, 0x119D0, propOLetter},
+	{0x119D1, 0x119D7, propExtend},
+	{0x119DA, 0x119E0, propExtend},
+	{0x119E1, 0x119E1, propOLetter},
+	{0x119E3, 0x119E3, propOLetter},
+	{0x119E4, 0x119E4, propExtend},
+	{0x11A00, 0x11A00, propOLetter},
+	{0x11A01, 0x11A0A, propExtend},
+	{0x11A0B, 0x11A32, propOLetter},
+	{0x11A33, 0x11A39, propExtend},
+	{0x11A3A, 0x11A3A, propOLetter},
+	{0x11A3B, 0x11A3E, propExtend},
+	{0x11A42, 0x11A43, propSTerm},
+	{0x11A47, 0x11A47, propExtend},
+	{0x11A50, 0x11A50, propOLetter},
+	{0x11A51, 0x11A5B, propExtend},
+	{0x11A5C, 0x11A89, propOLetter},
+	{0x11A8A, 0x11A99, propExtend},
+	{0x11A9B, 0x11A9C, propSTerm},
+	{0x11A9D, 0x11A9D, propOLetter},
+	{0x11AB0, 0x11AF8, propOLetter},
+	{0x11B60, 0x11B67, propExtend},
+	{0x11BC0, 0x11BE0, propOLetter},
+	{0x11BF0, 0x11BF9, propNumeric},
+	{0x11C00, 0x11C08, propOLetter},
+	{0x11C0A, 0x11C2E, propOLetter},
+	{0x11C2F, 0x11C36, propExtend},
+	{0x11C38, 0x11C3F, propExtend},
+	{0x11C40, 0x11C40, propOLetter},
+	{0x11C41, 0x11C42, propSTerm},
+	{0x11C50, 0x11C59, propNumeric},
+	{0x11C72, 0x11C8F, propOLetter},
+	{0x11C92, 0x11CA7, propExtend},
+	{0x11CA9, 0x11CB6, propExtend},
+	{0x11D00, 0x11D06, propOLetter},
+	{0x11D08, 0x11D09, propOLetter},
+	{0x11D0B, 0x11D30, propOLetter},
+	{0x11D31, 0x11D36, propExtend},
+	{0x11D3A, 0x11D3A, propExtend},
+	{0x11D3C, 0x11D3D, propExtend},
+	{0x11D3F, 0x11D45, propExtend},
+	{0x11D46, 0x11D46, propOLetter},
+	{0x11D47, 0x11D47, propExtend},
+	{0x11D50, 0x11D59, propNumeric},
+	{0x11D60, 0x11D65, propOLetter},
+	{0x11D67, 0x11D68, propOLetter},
+	{0x11D6A, 0x11D89, propOLetter},
+	{0x11D8A, 0x11D8E, propExtend},
+	{0x11D90, 0x11D91, propExtend},
+	{0x11D93, 0x11D97, propExtend},
+	{0x11D98, 0x11D98, propOLetter},
+	{0x11DA0, 0x11DA9, propNumeric},
+	{0x11DB0, 0x11DDB, propOLetter},
+	{0x11DE0, 0x11DE9, propNumeric},
+	{0x11EE0, 0x11EF2, propOLetter},
+	{0x11EF3, 0x11EF6, propExtend},
+	{0x11EF7, 0x11EF8, propSTerm},
+	{0x11F00, 0x11F01, propExtend},
+	{0x11F02, 0x11F02, propOLetter},
+	{0x11F03, 0x11F03, propExtend},
+	{0x11F04, 0x11F10, propOLetter},
+	{0x11F12, 0x11F33, propOLetter},
+	{0x11F34, 0x11F3A, propExtend},
+	{0x11F3E, 0x11F42, propExtend},
+	{0x11F43, 0x11F44, propSTerm},
+	{0x11F50, 0x11F59, propNumeric},
+	{0x11F5A, 0x11F5A, propExtend},
+	{0x11FB0, 0x11FB0, propOLetter},
+	{0x12000, 0x12399, propOLetter},
+	{0x12400, 0x1246E, propOLetter},
+	{0x12480, 0x12543, propOLetter},
+	{0x12F90, 0x12FF0, propOLetter},
+	{0x13000, 0x1342F, propOLetter},
+	{0x13430, 0x1343F, propFormat},
+	{0x13440, 0x13440, propExtend},
+	{0x13441, 0x13446, propOLetter},
+	{0x13447, 0x13455, propExtend},
+	{0x13460, 0x143FA, propOLetter},
+	{0x14400, 0x14646, propOLetter},
+	{0x16100, 0x1611D, propOLetter},
+	{0x1611E, 0x1612F, propExtend},
+	{0x16130, 0x16139, propNumeric},
+	{0x16800, 0x16A38, propOLetter},
+	{0x16A40, 0x16A5E, propOLetter},
+	{0x16A60, 0x16A69, propNumeric},
+	{0x16A6E, 0x16A6F, propSTerm},
+	{0x16A70, 0x16ABE, propOLetter},
+	{0x16AC0, 0x16AC9, propNumeric},
+	{0x16AD0, 0x16AED, propOLetter},
+	{0x16AF0, 0x16AF4, propExtend},
+	{0x16AF5, 0x16AF5, propSTerm},
+	{0x16B00, 0x16B2F, propOLetter},
+	{0x16B30, 0x16B36, propExtend},
+	{0x16B37, 0x16B38, propSTerm},
+	{0x16B40, 0x16B43, propOLetter},
+	{0x16B44, 0x16B44, propSTerm},
+	{0x16B50, 0x16B59, propNumeric},
+	{0x16B63, 0x16B77, propOLetter},
+	{0x16B7D, 0x16B8F, propOLetter},
+	{0x16D40, 0x16D6C, propOLetter},
+	{0x16D6E, 0x16D6F, propSTerm},
+	{0x16D70, 0x16D79, propNumeric},
+	{0x16E40, 0x16E5F, propUpper},
+	{0x16E60, 0x16E7F, propLower},
+	{0x16E98, 0x16E98, propSTerm},
+	{0x16EA0, 0x16EB8, propUpper},
+	{0x16EBB, 0x16ED3, propLower},
+	{0x16F00, 0x16F4A, propOLetter},
+	{0x16F4F, 0x16F4F, propExtend},
+	{0x16F50, 0x16F50, propOLetter},
+	{0x16F51, 0x16F87, propExtend},
+	{0x16F8F, 0x16F92, propExtend},
+	{0x16F93, 0x16F9F, propOLetter},
+	{0x16FE0, 0x16FE1, propOLetter},
+	{0x16FE3, 0x16FE3, propOLetter},
+	{0x16FE4, 0x16FE4, propExtend},
+	{0x16FF0, 0x16FF1, propExtend},
+	{0x16FF2, 0x16FF6, propOLetter},
+	{0x17000, 0x18CD5, propOLetter},
+	{0x18CFF, 0x18D1E, propOLetter},
+	{0x18D80, 0x18DF2, propOLetter},
+	{0x1AFF0, 0x1AFF3, propOLetter},
+	{0x1AFF5, 0x1AFFB, propOLetter},
+	{0x1AFFD, 0x1AFFE, propOLetter},
+	{0x1B000, 0x1B122, propOLetter},
+	{0x1B132, 0x1B132, propOLetter},
+	{0x1B150, 0x1B152, propOLetter},
+	{0x1B155, 0x1B155, propOLetter},
+	{0x1B164, 0x1B167, propOLetter},
+	{0x1B170, 0x1B2FB, propOLetter},
+	{0x1BC00, 0x1BC6A, propOLetter},
+	{0x1BC70, 0x1BC7C, propOLetter},
+	{0x1BC80, 0x1BC88, propOLetter},
+	{0x1BC90, 0x1BC99, propOLetter},
+	{0x1BC9D, 0x1BC9E, propExtend},
+	{0x1BC9F, 0x1BC9F, propSTerm},
+	{0x1BCA0, 0x1BCA3, propFormat},
+	{0x1CCF0, 0x1CCF9, propNumeric},
+	{0x1CF00, 0x1CF2D, propExtend},
+	{0x1CF30, 0x1CF46, propExtend},
+	{0x1D165, 0x1D169, propExtend},
+	{0x1D16D, 0x1D172, propExtend},
+	{0x1D173, 0x1D17A, propFormat},
+	{0x1D17B, 0x1D182, propExtend},
+	{0x1D185, 0x1D18B, propExtend},
+	{0x1D1AA, 0x1D1AD, propExtend},
+	{0x1D242, 0x1D244, propExtend},
+	{0x1D400, 0x1D419, propUpper},
+	{0x1D41A, 0x1D433, propLower},
+	{0x1D434, 0x1D44D, propUpper},
+	{0x1D44E, 0x1D454, propLower},
+	{0x1D456, 0x1D467, propLower},
+	{0x1D468, 0x1D481, propUpper},
+	{0x1D482, 0x1D49B, propLower},
+	{0x1D49C, 0x1D49C, propUpper},
+	{0x1D49E, 0x1D49F, propUpper},
+	{0x1D4A2, 0x1D4A2, propUpper},
+	{0x1D4A5, 0x1D4A6, propUpper},
+	{0x1D4A9, 0x1D4AC, propUpper},
+	{0x1D4AE, 0x1D4B5, propUpper},
+	{0x1D4B6, 0x1D4B9, propLower},
+	{0x1D4BB, 0x1D4BB, propLower},
+	{0x1D4BD, 0x1D4C3, propLower},
+	{0x1D4C5, 0x1D4CF, propLower},
+	{0x1D4D0, 0x1D4E9, propUpper},
+	{0x1D4EA, 0x1D503, propLower},
+	{0x1D504, 0x1D505, propUpper},
+	{0x1D507, 0x1D50A, propUpper},
+	{0x1D50D, 0x1D514, propUpper},
+	{0x1D516, 0x1D51C, propUpper},
+	{0x1D51E, 0x1D537, propLower},
+	{0x1D538, 0x1D539, propUpper},
+	{0x1D53B, 0x1D53E, propUpper},
+	{0x1D540, 0x1D544, propUpper},
+	{0x1D546, 0x1D546, propUpper},
+	{0x1D54A, 0x1D550, propUpper},
+	{0x1D552, 0x1D56B, propLower},
+	{0x1D56C, 0x1D585, propUpper},
+	{0x1D586, 0x1D59F, propLower},
+	{0x1D5A0, 0x1D5B9, propUpper},
+	{0x1D5BA, 0x1D5D3, propLower},
+	{0x1D5D4, 0x1D5ED, propUpper},
+	{0x1D5EE, 0x1D607, propLower},
+	{0x1D608, 0x1D621, propUpper},
+	{0x1D622, 0x1D63B, propLower},
+	{0x1D63C, 0x1D655, propUpper},
+	{0x1D656, 0x1D66F, propLower},
+	{0x1D670, 0x1D689, propUpper},
+	{0x1D68A, 0x1D6A5, propLower},
+	{0x1D6A8, 0x1D6C0, propUpper},
+	{0x1D6C2, 0x1D6DA, propLower},
+	{0x1D6DC, 0x1D6E1, propLower},
+	{0x1D6E2, 0x1D6FA, propUpper},
+	{0x1D6FC, 0x1D714, propLower},
+	{0x1D716, 0x1D71B, propLower},
+	{0x1D71C, 0x1D734, propUpper},
+	{0x1D736, 0x1D74E, propLower},
+	{0x1D750, 0x1D755, propLower},
+	{0x1D756, 0x1D76E, propUpper},
+	{0x1D770, 0x1D788, propLower},
+	{0x1D78A, 0x1D78F, propLower},
+	{0x1D790, 0x1D7A8, propUpper},
+	{0x1D7AA, 0x1D7C2, propLower},
+	{0x1D7C4, 0x1D7C9, propLower},
+	{0x1D7CA, 0x1D7CA, propUpper},
+	{0x1D7CB, 0x1D7CB, propLower},
+	{0x1D7CE, 0x1D7FF, propNumeric},
+	{0x1DA00, 0x1DA36, propExtend},
+	{0x1DA3B, 0x1DA6C, propExtend},
+	{0x1DA75, 0x1DA75, propExtend},
+	{0x1DA84, 0x1DA84, propExtend},
+	{0x1DA88, 0x1DA88, propSTerm},
+	{0x1DA9B, 0x1DA9F, propExtend},
+	{0x1DAA1, 0x1DAAF, propExtend},
+	{0x1DF00, 0x1DF09, propLower},
+	{0x1DF0A, 0x1DF0A, propOLetter},
+	{0x1DF0B, 0x1DF1E, propLower},
+	{0x1DF25, 0x1DF2A, propLower},
+	{0x1E000, 0x1E006, propExtend},
+	{0x1E008, 0x1E018, propExtend},
+	{0x1E01B, 0x1E021, propExtend},
+	{0x1E023, 0x1E024, propExtend},
+	{0x1E026, 0x1E02A, propExtend},
+	{0x1E030, 0x1E06D, propLower},
+	{0x1E08F, 0x1E08F, propExtend},
+	{0x1E100, 0x1E12C, propOLetter},
+	{0x1E130, 0x1E136, propExtend},
+	{0x1E137, 0x1E13D, propOLetter},
+	{0x1E140, 0x1E149, propNumeric},
+	{0x1E14E, 0x1E14E, propOLetter},
+	{0x1E290, 0x1E2AD, propOLetter},
+	{0x1E2AE, 0x1E2AE, propExtend},
+	{0x1E2C0, 0x1E2EB, propOLetter},
+	{0x1E2EC, 0x1E2EF, propExtend},
+	{0x1E2F0, 0x1E2F9, propNumeric},
+	{0x1E4D0, 0x1E4EB, propOLetter},
+	{0x1E4EC, 0x1E4EF, propExtend},
+	{0x1E4F0, 0x1E4F9, propNumeric},
+	{0x1E5D0, 0x1E5ED, propOLetter},
+	{0x1E5EE, 0x1E5EF, propExtend},
+	{0x1E5F0, 0x1E5F0, propOLetter},
+	{0x1E5F1, 0x1E5FA, propNumeric},
+	{0x1E6C0, 0x1E6DE, propOLetter},
+	{0x1E6E0, 0x1E6E2, propOLetter},
+	{0x1E6E3, 0x1E6E3, propExtend},
+	{0x1E6E4, 0x1E6E5, propOLetter},
+	{0x1E6E6, 0x1E6E6, propExtend},
+	{0x1E6E7, 0x1E6ED, propOLetter},
+	{0x1E6EE, 0x1E6EF, propExtend},
+	{0x1E6F0, 0x1E6F4, propOLetter},
+	{0x1E6F5, 0x1E6F5, propExtend},
+	{0x1E6FE, 0x1E6FF, propOLetter},
+	{0x1E7E0, 0x1E7E6, propOLetter},
+	{0x1E7E8, 0x1E7EB, propOLetter},
+	{0x1E7ED, 0x1E7EE, propOLetter},
+	{0x1E7F0, 0x1E7FE, propOLetter},
+	{0x1E800, 0x1E8C4, propOLetter},
+	{0x1E8D0, 0x1E8D6, propExtend},
+	{0x1E900, 0x1E921, propUpper},
+	{0x1E922, 0x1E943, propLower},
+	{0x1E944, 0x1E94A, propExtend},
+	{0x1E94B, 0x1E94B, propOLetter},
+	{0x1E950, 0x1E959, propNumeric},
+	{0x1EE00, 0x1EE03, propOLetter},
+	{0x1EE05, 0x1EE1F, propOLetter},
+	{0x1EE21, 0x1EE22, propOLetter},
+	{0x1EE24, 0x1EE24, propOLetter},
+	{0x1EE27, 0x1EE27, propOLetter},
+	{0x1EE29, 0x1EE32, propOLetter},
+	{0x1EE34, 0x1EE37, propOLetter},
+	{0x1EE39, 0x1EE39, propOLetter},
+	{0x1EE3B, 0x1EE3B, propOLetter},
+	{0x1EE42, 0x1EE42, propOLetter},
+	{0x1EE47, 0x1EE47, propOLetter},
+	{0x1EE49, 0x1EE49, propOLetter},
+	{0x1EE4B, 0x1EE4B, propOLetter},
+	{0x1EE4D, 0x1EE4F, propOLetter},
+	{0x1EE51, 0x1EE52, propOLetter},
+	{0x1EE54, 0x1EE54, propOLetter},
+	{0x1EE57, 0x1EE57, propOLetter},
+	{0x1EE59, 0x1EE59, propOLetter},
+	{0x1EE5B, 0x1EE5B, propOLetter},
+	{0x1EE5D, 0x1EE5D, propOLetter},
+	{0x1EE5F, 0x1EE5F, propOLetter},
+	{0x1EE61, 0x1EE62, propOLetter},
+	{0x1EE64, 0x1EE64, propOLetter},
+	{0x1EE67, 0x1EE6A, propOLetter},
+	{0x1EE6C, 0x1EE72, propOLetter},
+	{0x1EE74, 0x1EE77, propOLetter},
+	{0x1EE79, 0x1EE7C, propOLetter},
+	{0x1EE7E, 0x1EE7E, propOLetter},
+	{0x1EE80, 0x1EE89, propOLetter},
+	{0x1EE8B, 0x1EE9B, propOLetter},
+	{0x1EEA1, 0x1EEA3, propOLetter},
+	{0x1EEA5, 0x1EEA9, propOLetter},
+	{0x1EEAB, 0x1EEBB, propOLetter},
+	{0x1F130, 0x1F149, propUpper},
+	{0x1F150, 0x1F169, propUpper},
+	{0x1F170, 0x1F189, propUpper},
+	{0x1F676, 0x1F678, propClose},
+	{0x1FBF0, 0x1FBF9, propNumeric},
+	{0x20000, 0x2A6DF, propOLetter},
+	{0x2A700, 0x2B81D, propOLetter},
+	{0x2B820, 0x2CEAD, propOLetter},
+	{0x2CEB0, 0x2EBE0, propOLetter},
+	{0x2EBF0, 0x2EE5D, propOLetter},
+	{0x2F800, 0x2FA1D, propOLetter},
+	{0x30000, 0x3134A, propOLetter},
+	{0x31350, 0x33479, propOLetter},
+	{0xE0001, 0xE0001, propFormat},
+	{0xE0020, 0xE007F, propExtend},
+	{0xE0100, 0xE01EF, propExtend},
+}
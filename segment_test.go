@@ -0,0 +1,152 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func segments(split bufio.SplitFunc, text string) []string {
+	s := bufio.NewScanner(strings.NewReader(text))
+	s.Buffer(make([]byte, 4), 1024)
+	s.Split(split)
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+	}
+	return seq
+}
+
+func TestSegmentGraphemes(t *testing.T) {
+	for input, expected := range map[string][]string{
+		"éx":    {"é", "x"},
+		"🇺🇸🇫🇷🇩":  {"🇺🇸", "🇫🇷", "🇩"},
+		"👨‍👩‍👧!": {"👨‍👩‍👧", "!"},
+		"👍🏽👍":    {"👍🏽", "👍"},
+		"a\r\nb": {"a", "\r\n", "b"},
+		"각가":   {"각", "가"},
+		"a‍b":    {"a‍", "b"},
+		"नमस्ते": {"न", "म", "स्ते"},
+		"क्त":    {"क्त"},
+	} {
+		it.Then(t).Should(
+			it.Seq(segments(scanner.SegmentGraphemes, input)).Equal(expected...),
+		)
+	}
+}
+
+func TestSegmentWords(t *testing.T) {
+	for input, expected := range map[string][]string{
+		`The quick ("brown") fox can't jump 32.3 feet, right?`: {
+			"The", " ", "quick", " ", "(", `"`, "brown", `"`, ")", " ", "fox", " ",
+			"can't", " ", "jump", " ", "32.3", " ", "feet", ",", " ", "right", "?",
+		},
+		"e.g. 3,000.50 a_b": {"e.g", ".", " ", "3,000.50", " ", "a_b"},
+		"カタカナ漢字ひら":          {"カタカナ", "漢", "字", "ひ", "ら"},
+		"a  \r\nb":          {"a", "  ", "\r\n", "b"},
+		"Wörld́s:test.":     {"Wörld́s:test", "."},
+		"🇺🇸🇫🇷 👨‍👩":          {"🇺🇸", "🇫🇷", " ", "👨‍👩"},
+		"א\"ב א'":           {"א\"ב", " ", "א'"},
+	} {
+		it.Then(t).Should(
+			it.Seq(segments(scanner.SegmentWords, input)).Equal(expected...),
+		)
+	}
+}
+
+func TestSegmentSentences(t *testing.T) {
+	for input, expected := range map[string][]string{
+		"This is a test. And another one! Done":  {"This is a test. ", "And another one! ", "Done"},
+		"He said “Hi.” Then left.":               {"He said “Hi.” ", "Then left."},
+		"It is etc. and more. Next":              {"It is etc. and more. ", "Next"},
+		"U.S.A. is big. 3.5 is fine.":            {"U.S.A. is big. ", "3.5 is fine."},
+		"Hello.\nWorld":                          {"Hello.\n", "World"},
+		"No terminator\r\nhere":                  {"No terminator\r\n", "here"},
+		"What?! Yes, really? Next":               {"What?! ", "Yes, really? ", "Next"},
+		"今日は晴れです。明日は雨！":                          {"今日は晴れです。", "明日は雨！"},
+		"He left (at 5 p.m.) yesterday. Then go": {"He left (at 5 p.m.) yesterday. ", "Then go"},
+	} {
+		it.Then(t).Should(
+			it.Seq(segments(scanner.SegmentSentences, input)).Equal(expected...),
+		)
+	}
+}
+
+// Conformance tests use files from Unicode Character Database.
+func TestSegmentConformance(t *testing.T) {
+	for file, split := range map[string]bufio.SplitFunc{
+		"GraphemeBreakTest.txt": scanner.SegmentGraphemes,
+		"WordBreakTest.txt":     scanner.SegmentWords,
+		"SentenceBreakTest.txt": scanner.SegmentSentences,
+	} {
+		t.Run(file, func(t *testing.T) {
+			f, err := os.Open(filepath.Join("ucd", file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			cases := 0
+			lines := bufio.NewScanner(f)
+			for n := 1; lines.Scan(); n++ {
+				input, expected := conformance(lines.Text())
+				if len(expected) == 0 {
+					continue
+				}
+
+				cases++
+				seq := segments(split, input)
+				if strings.Join(seq, "÷") != strings.Join(expected, "÷") {
+					t.Errorf("%s:%d: %q expected %q", file, n, seq, expected)
+				}
+			}
+
+			if err := lines.Err(); err != nil || cases == 0 {
+				t.Fatalf("no conformance cases: %v", err)
+			}
+		})
+	}
+}
+
+// parse the line of conformance file: ÷ 0061 × 0308 ÷ # comment
+func conformance(line string) (string, []string) {
+	if at := strings.IndexByte(line, '#'); at >= 0 {
+		line = line[:at]
+	}
+
+	var sb strings.Builder
+	seq := make([]string, 0)
+	for _, field := range strings.Fields(line) {
+		switch field {
+		case "÷":
+			if sb.Len() > 0 {
+				seq = append(seq, sb.String())
+				sb.Reset()
+			}
+		case "×":
+		default:
+			r, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				return "", nil
+			}
+			sb.WriteRune(rune(r))
+		}
+	}
+
+	return strings.Join(seq, ""), seq
+}
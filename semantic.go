@@ -10,7 +10,8 @@ package scanner
 
 import (
 	"context"
-	"fmt"
+	"slices"
+	"strings"
 )
 
 // Semantic provides a convenient solution for semantic chunking.
@@ -32,22 +33,50 @@ import (
 // The module provides high, medium, weak and dissimilarity functions based on
 // cosine distance.
 //
+// By default, the scanner regroups similar sentences across the whole context
+// window, the document order is not preserved. Use Grouping method to switch
+// the scanner into contiguous mode, where chunks are cut only at boundaries of
+// adjacent sentences. Use Breakpoint method for adaptive thresholds computed
+// from the distribution of distances between adjacent sentences in the window.
+//
 // Scanning stops unrecoverably at EOF or the first I/O error.
 type Semantic struct {
 	embed                 Embedder
 	confSimilarity        func([]float32, []float32) bool
 	confWindowInSentences int
 	confSimilarityWith    SimilarityWith
+	confConcurrency       int
+	confBudget            int
+	confOverlap           int
+	confGrouping          Grouping
+	confBreakpoint        func([]float32) []bool
 	scanner               Scanner
 	err                   error
+	usage                 Usage
 	eof                   bool
+	track                 tracker
 	window                []vector
 	cursor                []string
+	chunk                 Chunk
+	tokens                int
+	vectors               [][]float32
+	last                  []vector
 }
 
+// Configure grouping algorithm of semantic chunking
+type Grouping int
+
+// Configure grouping algorithm of semantic chunking
+const (
+	GROUPING_WINDOW Grouping = iota
+	GROUPING_CONTIGUOUS
+)
+
 type vector struct {
-	text string
-	vf32 []float32
+	text   string
+	vf32   []float32
+	chunk  Chunk
+	tokens int
 }
 
 // Creates new instance of Scanner to read from io.Reader and using embedding.
@@ -57,7 +86,10 @@ func NewSemantic(embed Embedder, r Scanner) *Semantic {
 		confSimilarity:        HighSimilarity,
 		confWindowInSentences: 32,
 		confSimilarityWith:    SIMILARITY_WITH_TAIL,
+		confConcurrency:       1,
+		confGrouping:          GROUPING_WINDOW,
 		scanner:               r,
+		track:                 newTracker(),
 		window:                make([]vector, 0),
 	}
 }
@@ -80,6 +112,62 @@ func (s *Semantic) SimilarityWith(x SimilarityWith) {
 	s.confSimilarityWith = x
 }
 
+// Grouping sets the behavior of chunking algorithm.
+//
+// Using GROUPING_WINDOW configures algorithm to pull every similar sentence
+// from the context window into the chunk. Chunks might be composed of
+// non-adjacent sentences, the document order is not preserved.
+//
+// Using GROUPING_CONTIGUOUS configures algorithm to cut the stream of sentences
+// at the first sentence that is not similar. Every chunk is a contiguous span
+// of the source, the document order is preserved. The chunk never exceeds
+// the context window.
+//
+// Grouping resets the breakpoint function (see [Semantic.Breakpoint]),
+// the similarity function is used again.
+func (s *Semantic) Grouping(x Grouping) {
+	s.confGrouping = x
+	s.confBreakpoint = nil
+}
+
+// Breakpoint sets the adaptive breakpoint function for the Semantic, which
+// implies contiguous grouping. The function receives cosine distances between
+// adjacent sentences of the context window and marks ones to split at.
+// The chunk is cut at the first marked distance. The module provides
+// percentile, standard deviation, interquartile and gradient breakpoints.
+// The similarity function is not used when the breakpoint is defined, use
+// [Semantic.Grouping] to reset the breakpoint.
+func (s *Semantic) Breakpoint(f func([]float32) []bool) {
+	s.confBreakpoint = f
+	s.confGrouping = GROUPING_CONTIGUOUS
+}
+
+// Concurrency defines the number of embedding requests in-flight while
+// filling the context window, the order of sentences is preserved.
+// The default value is 1, sentences are embedded sequentially.
+// It has no effect if the embedder embeds batches natively (e.g. the
+// provider's [BatchEmbedder]), it applies to [Cache] or [Retry] wrapping
+// a plain embedder.
+func (s *Semantic) Concurrency(n int) {
+	s.confConcurrency = n
+}
+
+// Budget defines the maximum number of tokens used by the scan. The scan stops
+// with [BudgetExceededError] once the budget is exceeded.
+// The default value is 0, the budget is unlimited.
+func (s *Semantic) Budget(tokens int) {
+	s.confBudget = tokens
+}
+
+// Overlap sets the number of sentences repeated from the tail of preceding
+// chunk at the head of the following one. The length of overlap region is
+// available through [Chunk] metadata. Tokens used by overlapping sentences
+// are not included into [Semantic.ChunkTokens].
+// The default value is 0, chunks are disjoint.
+func (s *Semantic) Overlap(n int) {
+	s.confOverlap = n
+}
+
 // Widow defines the context window for similarity detection.
 // The default value is 32 sentences.
 func (s *Semantic) Window(n int) {
@@ -89,15 +177,45 @@ func (s *Semantic) Window(n int) {
 func (s *Semantic) Err() error     { return s.err }
 func (s *Semantic) Text() []string { return s.cursor }
 
+// stops the upstream created by the library (see [FromSeq])
+func (s *Semantic) release() { release(s.scanner) }
+
+// Chunk returns sentences of the current chunk, joined by space, annotated with
+// every contributing span of the source.
+func (s *Semantic) Chunk() Chunk { return s.chunk }
+
+// Usage returns tokens and calls used by embeddings since the beginning of scan.
+func (s *Semantic) Usage() Usage { return s.usage }
+
+// ChunkTokens returns tokens used to embed sentences of the current chunk.
+func (s *Semantic) ChunkTokens() int { return s.tokens }
+
+// Vectors returns embedding vectors of sentences of the current chunk,
+// in the same order as [Semantic.Text].
+func (s *Semantic) Vectors() [][]float32 { return s.vectors }
+
+// Centroid returns the mean vector of the current chunk, optionally
+// normalized to the unit length.
+func (s *Semantic) Centroid(normalize bool) []float32 {
+	return Centroid(s.vectors, normalize)
+}
+
 // Scan advances the Semantic through context window, sequences will be available
 // through [Semantic.Text]. It returns false if there was I/O error or EOF is reached.
 func (s *Semantic) Scan() bool {
+	return s.ScanContext(context.Background())
+}
+
+// ScanContext is like [Semantic.Scan] but threads the context into every call
+// of embedding. It returns false if the context is cancelled, the context's
+// error is available through [Semantic.Err].
+func (s *Semantic) ScanContext(ctx context.Context) bool {
 	if s.err != nil {
 		return false
 	}
 
 	if !s.eof {
-		s.eof, s.err = s.fill()
+		s.eof, s.err = s.fill(ctx)
 		if s.err != nil {
 			return false
 		}
@@ -109,16 +227,11 @@ func (s *Semantic) Scan() bool {
 }
 
 // fill the window
-func (s *Semantic) fill() (bool, error) {
+func (s *Semantic) fill(ctx context.Context) (bool, error) {
 	wn := s.confWindowInSentences - len(s.window)
+	seq := make([]vector, 0, max(wn, 0))
 	for wn > 0 && s.scanner.Scan() {
-		txt := s.scanner.Text()
-		v32, _, err := s.embed.Embedding(context.Background(), txt)
-		if err != nil {
-			return false, fmt.Errorf("embedding has failed: %w, for {%s}", err, txt)
-		}
-
-		s.window = append(s.window, vector{text: txt, vf32: v32})
+		seq = append(seq, vector{text: s.scanner.Text(), chunk: s.track.chunkOf(s.scanner)})
 		wn--
 	}
 
@@ -126,28 +239,87 @@ func (s *Semantic) fill() (bool, error) {
 		return false, err
 	}
 
+	if len(seq) > 0 {
+		text := make([]string, len(seq))
+		for i, x := range seq {
+			text[i] = x.text
+		}
+
+		vectors, used, calls, err := embeddings(ctx, s.embed, s.confConcurrency, text)
+		exceeded := s.usage.account(used, calls, s.confBudget)
+		if err != nil {
+			return false, err
+		}
+
+		if exceeded != nil {
+			return false, exceeded
+		}
+
+		for i := range seq {
+			seq[i].vf32 = vectors[i]
+			seq[i].tokens = used[i]
+		}
+		s.window = append(s.window, seq...)
+	}
+
 	return wn != 0, nil
 }
 
 // peek similar from the window
 func (s *Semantic) peek() []string {
 	if len(s.window) == 0 {
+		s.chunk = Chunk{}
+		s.tokens = 0
+		s.vectors = nil
 		return nil
 	}
 
-	// split the window into similar (a) and non-similar (b) items
+	var a []vector
+	switch {
+	case s.confBreakpoint != nil:
+		a = s.peekBreakpoint()
+	case s.confGrouping == GROUPING_CONTIGUOUS:
+		a = s.peekContiguous()
+	default:
+		a = s.peekWindow()
+	}
+
+	// the tail of preceding chunk is repeated at the head
+	overlap := 0
+	if s.confOverlap > 0 && len(s.last) > 0 {
+		overlap = min(s.confOverlap, len(s.last))
+		a = append(slices.Clone(s.last[len(s.last)-overlap:]), a...)
+	}
+	s.last = a
+
+	seq := make([]string, len(a))
+	pos := make([]Chunk, len(a))
+	s.tokens = 0
+	s.vectors = make([][]float32, len(a))
+	for i, x := range a {
+		seq[i] = x.text
+		pos[i] = x.chunk
+		s.vectors[i] = x.vf32
+		if i >= overlap {
+			s.tokens += x.tokens
+		}
+	}
+
+	s.chunk = joinChunks(strings.Join(seq, " "), pos)
+	if overlap > 0 {
+		s.chunk.Overlap = len(strings.Join(seq[:overlap], " ")) + 1
+	}
+
+	return seq
+}
+
+// split the window into similar (a) and non-similar (b) items
+func (s *Semantic) peekWindow() []vector {
 	a, b := make([]vector, 0), make([]vector, 0)
 	a = append(a, s.window[0])
 
 	for i := 1; i < len(s.window); i++ {
-		var at int
-		switch s.confSimilarityWith {
-		case SIMILARITY_WITH_HEAD:
-			at = 0
-		case SIMILARITY_WITH_TAIL:
-			at = len(a) - 1
-		}
-		ref := a[at]
+		ref := a[s.refAt(a)]
 
 		if s.confSimilarity(ref.vf32, s.window[i].vf32) {
 			a = append(a, s.window[i])
@@ -157,10 +329,54 @@ func (s *Semantic) peek() []string {
 	}
 
 	s.window = b
+	return a
+}
 
-	seq := make([]string, len(a))
-	for i, x := range a {
-		seq[i] = x.text
+// take the longest run of similar items from the head of window
+func (s *Semantic) peekContiguous() []vector {
+	n := 1
+	for ; n < len(s.window); n++ {
+		ref := s.window[:n][s.refAt(s.window[:n])]
+		if !s.confSimilarity(ref.vf32, s.window[n].vf32) {
+			break
+		}
+	}
+
+	return s.cut(n)
+}
+
+// take items from the head of window until the first breakpoint
+func (s *Semantic) peekBreakpoint() []vector {
+	d := make([]float32, len(s.window)-1)
+	for i := range d {
+		d[i] = cosine(s.window[i].vf32, s.window[i+1].vf32)
+	}
+
+	n := len(s.window)
+	for i, split := range s.confBreakpoint(d) {
+		if split && i < len(d) {
+			n = i + 1
+			break
+		}
+	}
+
+	return s.cut(n)
+}
+
+// cut n items from the head of window
+func (s *Semantic) cut(n int) []vector {
+	a := make([]vector, n)
+	copy(a, s.window[:n])
+	s.window = append(s.window[:0], s.window[n:]...)
+	return a
+}
+
+// position of reference item within chunk
+func (s *Semantic) refAt(a []vector) int {
+	switch s.confSimilarityWith {
+	case SIMILARITY_WITH_HEAD:
+		return 0
+	default:
+		return len(a) - 1
 	}
-	return seq
 }
@@ -10,6 +10,7 @@ package scanner_test
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 
@@ -41,6 +42,207 @@ func TestScanner(t *testing.T) {
 	)
 }
 
+func TestScannerChunk(t *testing.T) {
+	text := "a. bb. c. ddd. ff."
+
+	s := scanner.NewSemantic(
+		embed{},
+		scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Similarity(similar)
+	s.Window(3)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Equal(s.Chunk().Text, "a. c."),
+		it.Seq(s.Chunk().Sentences).Equal(0, 2),
+		it.Equal(len(s.Chunk().Spans), 2),
+		it.Equal(text[s.Chunk().Spans[0].Start.Offset:s.Chunk().Spans[0].End.Offset], "a."),
+		it.Equal(text[s.Chunk().Spans[1].Start.Offset:s.Chunk().Spans[1].End.Offset], "c."),
+		it.Equal(s.Chunk().Start.Offset, 0),
+		it.Equal(s.Chunk().End.Offset, 9),
+	)
+}
+
+func TestScannerContiguous(t *testing.T) {
+	text := "a. b. cc. dd. e. ff."
+
+	s := scanner.NewSemantic(
+		embed{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Similarity(similar)
+	s.Grouping(scanner.GROUPING_CONTIGUOUS)
+	s.Window(3)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a.", "b."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("cc.", "dd."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("e."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("ff."),
+	)
+
+	it.Then(t).ShouldNot(
+		it.True(s.Scan()),
+	)
+}
+
+func TestScannerContext(t *testing.T) {
+	text := "a. bb. c. ddd. ff."
+
+	s := scanner.NewSemantic(
+		embed{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Similarity(similar)
+	s.Window(3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it.Then(t).Should(
+		it.True(s.ScanContext(ctx)),
+		it.Seq(s.Text()).Equal("a.", "c."),
+	)
+
+	cancel()
+	it.Then(t).ShouldNot(
+		it.True(s.ScanContext(ctx)),
+	).Should(
+		it.Fail(s.Err).Contain("context canceled"),
+	)
+}
+
+func TestScannerConcurrency(t *testing.T) {
+	text := "a. bb. c. ddd. ff. g. hh. iii."
+
+	s := scanner.NewSemantic(
+		embed{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Similarity(similar)
+	s.Concurrency(4)
+	s.Window(8)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a.", "c.", "g."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("bb.", "ff.", "hh."),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("ddd.", "iii."),
+	)
+
+	it.Then(t).ShouldNot(
+		it.True(s.Scan()),
+	)
+}
+
+func TestScannerConcurrencyFailure(t *testing.T) {
+	s := scanner.NewSemantic(
+		failing{},
+		scanner.NewSlicer(" ", strings.NewReader("a. bb. fail c.")),
+	)
+	s.Concurrency(4)
+
+	it.Then(t).ShouldNot(
+		it.True(s.Scan()),
+	).Should(
+		it.Fail(s.Err).Contain("embedding has failed"),
+	)
+}
+
+func TestScannerUsage(t *testing.T) {
+	text := "a1. b22. a3. b4."
+
+	s := scanner.NewSemantic(
+		topic{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Window(4)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a1.", "a3."),
+		it.Equal(s.ChunkTokens(), 6),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("b22.", "b4."),
+		it.Equal(s.ChunkTokens(), 7),
+		it.Equal(s.Usage(), scanner.Usage{Tokens: 13, Calls: 4}),
+	)
+}
+
+func TestScannerBudget(t *testing.T) {
+	text := "a1. b22. a3. b4."
+
+	s := scanner.NewSemantic(
+		topic{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Window(2)
+	s.Budget(8)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a1."),
+	).ShouldNot(
+		it.True(s.Scan()),
+	)
+
+	var err *scanner.BudgetExceededError
+	it.Then(t).Should(
+		it.True(errors.As(s.Err(), &err)),
+		it.Equal(err.Used, 10),
+	)
+}
+
+func TestScannerVectors(t *testing.T) {
+	text := "a1. b2. a3."
+
+	s := scanner.NewSemantic(
+		topic{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a1.", "a3."),
+		it.Equal(len(s.Vectors()), 2),
+		it.Seq(s.Vectors()[0]).Equal(1, 0, 0, 0),
+		it.Seq(s.Centroid(true)).Equal(1, 0, 0, 0),
+	)
+}
+
+func TestScannerOverlap(t *testing.T) {
+	text := "a. b. cc. dd. e."
+
+	s := scanner.NewSemantic(
+		embed{},
+		scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(text)),
+	)
+	s.Similarity(similar)
+	s.Grouping(scanner.GROUPING_CONTIGUOUS)
+	s.Overlap(1)
+
+	it.Then(t).Should(
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("a.", "b."),
+		it.Equal(s.Chunk().Overlap, 0),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("b.", "cc.", "dd."),
+		it.Equal(s.Chunk().Overlap, 3),
+		it.Seq(s.Chunk().Sentences).Equal(1, 2, 3),
+		it.True(s.Scan()),
+		it.Seq(s.Text()).Equal("dd.", "e."),
+	)
+
+	it.Then(t).ShouldNot(
+		it.True(s.Scan()),
+	)
+}
+
 //------------------------------------------------------------------------------
 
 type embed struct{}
@@ -12,10 +12,15 @@ import (
 	"bufio"
 	"bytes"
 	"io"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
 
+// Sentencer is the sentence terminators, each rune of the string is
+// the terminator. The sentence ends with terminator followed by whitespace.
+// Use [NewSentenceSplit] for rules of abbreviations, initials, numbers,
+// quotes and writing systems.
 type Sentencer []byte
 
 // [bufio.SplitFunc] for sentence.
@@ -34,13 +39,17 @@ func (s Sentencer) Split(data []byte, atEOF bool) (advance int, token []byte, er
 		}
 	}
 
+	if atEOF && start == len(data) {
+		return len(data), nil, nil
+	}
+
 	// Scan until end of sentence [.!?]\s+|\z
-	var r rune
-	for width, i := 0, start; i < len(data)-1; i += width {
-		r, width = utf8.DecodeRune(data[i+1:])
-		if bytes.IndexByte([]byte(s), data[i]) >= 0 {
-			if unicode.IsSpace(r) {
-				return i + 1, data[start : i+1], nil
+	for width, i := 0, start; i < len(data); i += width {
+		var r rune
+		r, width = utf8.DecodeRune(data[i:])
+		if bytes.ContainsRune(s, r) {
+			if next, n := utf8.DecodeRune(data[i+width:]); n > 0 && unicode.IsSpace(next) {
+				return i + width, data[start : i+width], nil
 			}
 		}
 	}
@@ -54,6 +63,317 @@ func (s Sentencer) Split(data []byte, atEOF bool) (advance int, token []byte, er
 	return 0, nil, nil
 }
 
+// SentenceSplit defines rules of breaking the text into sentences.
+// The sentence ends with terminator followed by whitespace (see
+// [SentenceSplit.Spacing]) unless the terminator belongs to abbreviation or
+// ellipsis continued by lowercase word. Closing quotes and brackets following
+// the terminator are attached to the sentence they end. Rules of initials
+// and numbers are optional.
+type SentenceSplit struct {
+	eos           string
+	spacing       Spacing
+	abbreviations map[string]struct{}
+	initials      bool
+	numbers       bool
+	balanced      bool
+	paragraphs    bool
+}
+
+// Configure spacing after the sentence terminator
+type Spacing int
+
+// Configure spacing after the sentence terminator
+const (
+	SPACING_REQUIRED Spacing = iota
+	SPACING_OPTIONAL
+)
+
+// Script defines sentence terminators and spacing conventions of
+// the writing system.
+type Script struct {
+	EndOfSentence string
+	Spacing       Spacing
+}
+
+// Presets of writing systems
+var (
+	ScriptLatin      = Script{EndOfSentence, SPACING_REQUIRED}
+	ScriptCJK        = Script{EndOfSentenceCJK, SPACING_OPTIONAL}
+	ScriptDevanagari = Script{EndOfSentenceDevanagari, SPACING_REQUIRED}
+	ScriptArabic     = Script{EndOfSentenceArabic, SPACING_REQUIRED}
+	ScriptArmenian   = Script{EndOfSentenceArmenian, SPACING_REQUIRED}
+)
+
+// Creates sentence split rules, eos defines terminators of sentence, each
+// rune of the string is the terminator. Use Abbreviations method to define
+// abbreviations, none is defined by default.
+func NewSentenceSplit(eos string) *SentenceSplit {
+	if len(eos) == 0 {
+		eos = EndOfSentence
+	}
+
+	return &SentenceSplit{
+		eos:           eos,
+		spacing:       SPACING_REQUIRED,
+		abbreviations: make(map[string]struct{}),
+	}
+}
+
+// Abbreviations adds abbreviations, which do not terminate the sentence.
+// Abbreviations are case-insensitive and defined without the trailing dot
+// (e.g. "dr", "e.g"). The module provides lists for English, German, French,
+// Spanish and Italian languages.
+func (s *SentenceSplit) Abbreviations(abbr ...string) {
+	for _, x := range abbr {
+		s.abbreviations[strings.ToLower(strings.TrimSuffix(x, "."))] = struct{}{}
+	}
+}
+
+// Initials configures the splitter to not break the sentence at initials,
+// single uppercase letter followed by dot (e.g. "J. R. R. Tolkien"). The rule
+// is ambiguous for sentences ending with single letter (e.g. "grade A.").
+func (s *SentenceSplit) Initials() {
+	s.initials = true
+}
+
+// Numbers configures the splitter to not break the sentence at numbers
+// followed by dot if the sentence continues with digit or lowercase word
+// (e.g. "$3. 50 cents", "the 3. time").
+func (s *SentenceSplit) Numbers() {
+	s.numbers = true
+}
+
+// Spacing configures whitespace requirements after the terminator.
+// SPACING_REQUIRED is the default, the terminator must be followed by
+// whitespace. SPACING_OPTIONAL is used by scripts that do not separate
+// sentences with whitespace (e.g. Chinese or Japanese).
+func (s *SentenceSplit) Spacing(x Spacing) {
+	s.spacing = x
+}
+
+// Script configures terminators and spacing using the writing system preset
+// (e.g. [ScriptCJK] or [ScriptDevanagari]).
+func (s *SentenceSplit) Script(x Script) {
+	s.eos = x.EndOfSentence
+	s.spacing = x.Spacing
+}
+
+// Paragraphs configures the splitter to end the sentence at the paragraph
+// break (one or more blank lines) even if the terminator is missing
+// (e.g. headings or list items).
+func (s *SentenceSplit) Paragraphs() {
+	s.paragraphs = true
+}
+
+// Balanced configures the splitter to not break sentences inside balanced
+// quotes or brackets (e.g. `He said "Stop. Now." and left.`). The quote or
+// bracket is unbalanced (e.g. `12" pizza`) if it is not closed before
+// the blank line, the end of input or within the reach of 8 terminators,
+// the sentence ends at the first terminator it suppressed.
+func (s *SentenceSplit) Balanced() {
+	s.balanced = true
+}
+
+// [bufio.SplitFunc] for sentence.
+func (s *SentenceSplit) Split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	// Skip leading spaces.
+	start := 0
+	for width := 0; start < len(data); start += width {
+		var r rune
+		r, width = utf8.DecodeRune(data[start:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+	}
+
+	if atEOF && start == len(data) {
+		return len(data), nil, nil
+	}
+
+	// Scan until end of sentence [.!?][")\]]*\s+|\z
+	var (
+		r      rune
+		term   = -1
+		closed bool
+		blank  bool
+		stack  []rune
+		stray  = -1 // the first terminator suppressed by the quote
+		reach  int
+	)
+	for width, i := 0, start; i < len(data); i += width {
+		if !atEOF && !utf8.FullRune(data[i:]) {
+			// Request more data to decode the rune.
+			return 0, nil, nil
+		}
+		r, width = utf8.DecodeRune(data[i:])
+
+		if r == '\n' && s.paragraphs {
+			blank, more := blankLine(data[i+1:], atEOF)
+			if more {
+				// Request more data to look ahead of the line break.
+				return 0, nil, nil
+			}
+
+			if blank {
+				end := start + len(bytes.TrimRightFunc(data[start:i], unicode.IsSpace))
+				return end, data[start:end], nil
+			}
+		}
+
+		switch {
+		case strings.ContainsRune(s.eos, r):
+			term, closed = i+width, false
+		case term == i && strings.ContainsRune(closingQuotes, r):
+			term, closed = i+width, true
+		case term == i && (unicode.IsSpace(r) || s.spacing == SPACING_OPTIONAL):
+			next, ok := nextRune(data[i:], atEOF)
+			if !ok {
+				// Request more data to look ahead of the terminator.
+				return 0, nil, nil
+			}
+
+			if !s.continues(data[start:term], closed, next) {
+				if len(stack) == 0 {
+					return term, data[start:term], nil
+				}
+
+				if stray == -1 {
+					stray = term
+				}
+				if reach++; reach > balancedReach {
+					return stray, data[start:stray], nil
+				}
+			}
+		}
+
+		if s.balanced {
+			stack, blank = balance(stack, blank, r)
+			if len(stack) == 0 && stray != -1 {
+				if r == '\n' {
+					// The blank line resets unbalanced quote.
+					return stray, data[start:stray], nil
+				}
+				stray, reach = -1, 0
+			}
+		}
+	}
+
+	// If we're at EOF, we have a final, non-terminated line. Return it.
+	if atEOF {
+		if stray != -1 {
+			return stray, data[start:stray], nil
+		}
+		return len(data), data[start:], nil
+	}
+
+	// Request more data.
+	return 0, nil, nil
+}
+
+// The number of terminators suppressed by the quote or bracket, the quote is
+// unbalanced if it is not closed within the reach.
+const balancedReach = 8
+
+// Closing quotes and brackets attached to the sentence they end
+const closingQuotes = "\"')]}’”»」』"
+
+// Opening quotes and brackets mapped to closing ones
+var pairedQuotes = map[rune]rune{
+	'(': ')',
+	'[': ']',
+	'{': '}',
+	'“': '”',
+	'«': '»',
+	'「': '」',
+	'『': '』',
+}
+
+// track nesting of quotes and brackets, the blank line resets it
+func balance(stack []rune, blank bool, r rune) ([]rune, bool) {
+	switch {
+	case r == '\n' && blank:
+		return stack[:0], true
+	case r == '\n':
+		return stack, true
+	case unicode.IsSpace(r):
+		return stack, blank
+	case r == '"' && len(stack) > 0 && stack[len(stack)-1] == '"':
+		return stack[:len(stack)-1], false
+	case r == '"':
+		return append(stack, '"'), false
+	case len(stack) > 0 && stack[len(stack)-1] == r:
+		return stack[:len(stack)-1], false
+	}
+
+	if closer, has := pairedQuotes[r]; has {
+		return append(stack, closer), false
+	}
+
+	return stack, false
+}
+
+// first non-space rune, it is utf8.RuneError at EOF
+func nextRune(data []byte, atEOF bool) (rune, bool) {
+	for i := 0; i < len(data); {
+		r, width := utf8.DecodeRune(data[i:])
+		if !unicode.IsSpace(r) {
+			return r, true
+		}
+		i += width
+	}
+
+	return utf8.RuneError, atEOF
+}
+
+// checks if the sentence continues after the terminator
+func (s *SentenceSplit) continues(sentence []byte, closed bool, next rune) bool {
+	// quotation continued by lowercase word
+	if closed {
+		return unicode.IsLower(next)
+	}
+
+	// the last word including terminator
+	at := bytes.LastIndexFunc(sentence, unicode.IsSpace) + 1
+	word := bytes.TrimLeftFunc(sentence[at:], func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSymbol(r)
+	})
+
+	if !bytes.HasSuffix(word, []byte(".")) {
+		return false
+	}
+
+	// ellipsis continued by lowercase word
+	if bytes.HasSuffix(word, []byte("..")) {
+		return unicode.IsLower(next)
+	}
+
+	word = word[:len(word)-1]
+	if len(word) == 0 {
+		return false
+	}
+
+	// abbreviation
+	if _, has := s.abbreviations[strings.ToLower(string(word))]; has {
+		return true
+	}
+
+	// initials
+	if r, n := utf8.DecodeRune(word); s.initials && n == len(word) && unicode.IsUpper(r) {
+		return true
+	}
+
+	// decimal or ordinal numbers
+	if s.numbers && bytes.IndexFunc(word, func(r rune) bool { return !unicode.IsDigit(r) }) == -1 {
+		return unicode.IsDigit(next) || unicode.IsLower(next)
+	}
+
+	return false
+}
+
 // Create a scanner that slices input stream by end of sentence
 func NewSentencer(eos string, r io.Reader) *bufio.Scanner {
 	if len(eos) == 0 {
@@ -65,5 +385,23 @@ func NewSentencer(eos string, r io.Reader) *bufio.Scanner {
 	return s
 }
 
+// Create a scanner that slices input stream by end of sentence, sentences
+// are annotated with position at source document (see [Splitter.Chunk]).
+func NewSentences(eos string, r io.Reader) *Splitter {
+	if len(eos) == 0 {
+		eos = EndOfSentence
+	}
+
+	return NewSplitter(Sentencer(eos).Split, r)
+}
+
 // Default end of sentence
 const EndOfSentence = ".!?"
+
+// End of sentence for writing systems
+const (
+	EndOfSentenceCJK        = "。！？｡!?"
+	EndOfSentenceDevanagari = "।॥.!?"
+	EndOfSentenceArabic     = ".!؟۔"
+	EndOfSentenceArmenian   = "։"
+)
@@ -9,6 +9,7 @@
 package scanner_test
 
 import (
+	"bufio"
 	"strings"
 	"testing"
 
@@ -18,12 +19,14 @@ import (
 
 func TestSentencer(t *testing.T) {
 	for input, expected := range map[string][]string{
-		"Hello World!":      {"Hello World!"},
-		"Hello! World.":     {"Hello!", "World."},
-		"Hello!\nWorld.":    {"Hello!", "World."},
-		`Hello!\xWorld.`:    {`Hello!\xWorld.`},
-		"Hello 3.14 World!": {"Hello 3.14 World!"},
-		"Hello! World 3.14": {"Hello!", "World 3.14"},
+		"Hello World!":                     {"Hello World!"},
+		"Hello! World.":                    {"Hello!", "World."},
+		"Hello!\nWorld.":                   {"Hello!", "World."},
+		`Hello!\xWorld.`:                   {`Hello!\xWorld.`},
+		"Hello 3.14 World!":                {"Hello 3.14 World!"},
+		"Hello! World 3.14":                {"Hello!", "World 3.14"},
+		"I got a grade of A. Then I left.": {"I got a grade of A.", "Then I left."},
+		"It was 3. Then 4.":                {"It was 3.", "Then 4."},
 	} {
 		s := scanner.NewSentencer(scanner.EndOfSentence, strings.NewReader(input))
 
@@ -37,3 +40,149 @@ func TestSentencer(t *testing.T) {
 		)
 	}
 }
+
+func TestSentencerAbbreviations(t *testing.T) {
+	for input, expected := range map[string][]string{
+		"Dr. Smith paid $3. 50 cents were left.": {"Dr. Smith paid $3. 50 cents were left."},
+		"Use it e.g. this way. Then stop.":       {"Use it e.g. this way.", "Then stop."},
+		"J. R. R. Tolkien wrote it. Read it.":    {"J. R. R. Tolkien wrote it.", "Read it."},
+		"It was the 3. time. Wait... what? Yes.": {"It was the 3. time.", "Wait... what?", "Yes."},
+		"Wait... Then go.":                       {"Wait...", "Then go."},
+		"Call mr. Bean.":                         {"Call mr. Bean."},
+	} {
+		split := scanner.NewSentenceSplit(scanner.EndOfSentence)
+		split.Abbreviations(scanner.AbbreviationsEnglish...)
+		split.Initials()
+		split.Numbers()
+
+		s := scanner.NewSplitter(split.Split, strings.NewReader(input))
+		s.Buffer(make([]byte, 4), 1024)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(expected...),
+		)
+	}
+}
+
+func TestSentencerSplit(t *testing.T) {
+	s := bufio.NewScanner(strings.NewReader("I got a grade of A. Then I left."))
+	s.Split(scanner.Sentencer(".!?").Split)
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Seq(seq).Equal("I got a grade of A.", "Then I left."),
+	)
+}
+
+func TestSentencerQuotes(t *testing.T) {
+	for input, expected := range map[string][]string{
+		`He said "Stop." Then left.`:       {`He said "Stop."`, `Then left.`},
+		`He said "Stop." and left.`:        {`He said "Stop." and left.`},
+		`He said "Stop. Now." and left.`:   {`He said "Stop.`, `Now." and left.`},
+		"Он сказал «Стоп.» Ушёл.":          {"Он сказал «Стоп.»", "Ушёл."},
+		"(See the note.) Then go.":         {"(See the note.)", "Then go."},
+		"彼は「止まれ.」 Then go.":                {"彼は「止まれ.」", "Then go."},
+		"He said “Stop!”) Next one.":       {"He said “Stop!”)", "Next one."},
+		"He said 'Stop.' Then 'go.' Done.": {"He said 'Stop.'", "Then 'go.'", "Done."},
+	} {
+		split := scanner.NewSentenceSplit(scanner.EndOfSentence)
+		s := scanner.NewSplitter(split.Split, strings.NewReader(input))
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(expected...),
+		)
+	}
+}
+
+func TestSentencerBalanced(t *testing.T) {
+	for input, expected := range map[string][]string{
+		`He said "Stop. Now." and left. Next.`:  {`He said "Stop. Now." and left.`, `Next.`},
+		"He said “Stop. Now.” Next.":            {"He said “Stop. Now.”", "Next."},
+		"A (see b. c.) d. Next.":                {"A (see b. c.) d.", "Next."},
+		"A (see [b. c.] d. e.) f. Next.":        {"A (see [b. c.] d. e.) f.", "Next."},
+		"A \"unbalanced. Quote.\n\nNext. Done.": {"A \"unbalanced.", "Quote.", "Next.", "Done."},
+		`He bought a 12" pizza. Then left.`:     {`He bought a 12" pizza.`, `Then left.`},
+	} {
+		split := scanner.NewSentenceSplit(scanner.EndOfSentence)
+		split.Balanced()
+
+		s := scanner.NewSplitter(split.Split, strings.NewReader(input))
+		s.Buffer(make([]byte, 4), 1024)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(expected...),
+		)
+	}
+}
+
+func TestSentencerBalancedStray(t *testing.T) {
+	text := `He bought a 12" pizza. Then left.` + strings.Repeat(" The quick brown fox jumps over the lazy dog.", 2000)
+
+	split := scanner.NewSentenceSplit(scanner.EndOfSentence)
+	split.Balanced()
+
+	s := scanner.NewSplitter(split.Split, strings.NewReader(text))
+
+	seq := make([]string, 0)
+	for s.Scan() {
+		seq = append(seq, s.Text())
+	}
+
+	it.Then(t).Should(
+		it.Nil(s.Err()),
+		it.Equal(len(seq), 2002),
+		it.Equal(seq[0], `He bought a 12" pizza.`),
+		it.Equal(seq[1], `Then left.`),
+		it.Equal(seq[2], `The quick brown fox jumps over the lazy dog.`),
+	)
+}
+
+func TestSentencerScript(t *testing.T) {
+	for _, tt := range []struct {
+		script   scanner.Script
+		input    string
+		expected []string
+	}{
+		{scanner.ScriptCJK, "今日は晴れです。明日は雨！本当？", []string{"今日は晴れです。", "明日は雨！", "本当？"}},
+		{scanner.ScriptCJK, "「止まれ。」彼は言った。", []string{"「止まれ。」", "彼は言った。"}},
+		{scanner.ScriptCJK, "本当!?すごい。", []string{"本当!?", "すごい。"}},
+		{scanner.ScriptDevanagari, "यह एक वाक्य है। यह दूसरा है।", []string{"यह एक वाक्य है।", "यह दूसरा है।"}},
+		{scanner.ScriptArabic, "كيف حالك؟ أنا بخير.", []string{"كيف حالك؟", "أنا بخير."}},
+		{scanner.ScriptArmenian, "Բարեւ։ Ինչպես ես։", []string{"Բարեւ։", "Ինչպես ես։"}},
+		{scanner.ScriptLatin, "Hello!World. Next.", []string{"Hello!World.", "Next."}},
+	} {
+		split := scanner.NewSentenceSplit(scanner.EndOfSentence)
+		split.Script(tt.script)
+
+		s := scanner.NewSplitter(split.Split, strings.NewReader(tt.input))
+		s.Buffer(make([]byte, 4), 1024)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(tt.expected...),
+		)
+	}
+}
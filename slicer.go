@@ -12,6 +12,10 @@ import (
 	"bufio"
 	"bytes"
 	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
 )
 
 type Slicer []byte
@@ -43,3 +47,135 @@ func NewSlicer(delim string, r io.Reader) *bufio.Scanner {
 	s.Split(Slicer(delim).Split)
 	return s
 }
+
+// Create a scanner that slices input stream by fixed delimiter, slices are
+// annotated with position at source document (see [Splitter.Chunk]).
+func NewSlices(delim string, r io.Reader) *Splitter {
+	return NewSplitter(Slicer(delim).Split, r)
+}
+
+// RegexSlicer defines rules of slicing the input by delimiters, either
+// regular expression or set of literals. The earliest delimiter wins,
+// the longest one is preferred if delimiters start at the same position.
+type RegexSlicer struct {
+	re       *regexp.Regexp
+	maxLen   int
+	confMode Delimiter
+}
+
+// Configure retention of delimiters
+type Delimiter int
+
+// Configure retention of delimiters
+const (
+	DELIMITER_DROP Delimiter = iota
+	DELIMITER_PRECEDING
+	DELIMITER_FOLLOWING
+	DELIMITER_TOKEN
+)
+
+// Creates slicing rules using regular expression. The maxLen is the maximal
+// length of the delimiter in bytes, the match is accepted once maxLen bytes
+// are buffered after its start, so that the match is stable across buffer
+// boundaries. Delimiters are assumed to be shorter than 256 bytes if maxLen
+// is 0. Anchors (e.g. ^, $) are matched against the buffer, not the input.
+func NewRegexSplit(re *regexp.Regexp, maxLen int) *RegexSlicer {
+	if maxLen <= 0 {
+		maxLen = 256
+	}
+
+	return &RegexSlicer{
+		re:       re,
+		maxLen:   maxLen,
+		confMode: DELIMITER_DROP,
+	}
+}
+
+// Creates slicing rules using set of literal delimiters (e.g. "\n", "\r\n", ";").
+func NewDelimitersSplit(delims ...string) *RegexSlicer {
+	seq := slices.Clone(delims)
+	slices.SortStableFunc(seq, func(a, b string) int { return len(b) - len(a) })
+
+	maxLen := 0
+	for i, x := range seq {
+		maxLen = max(maxLen, len(x))
+		seq[i] = regexp.QuoteMeta(x)
+	}
+
+	return NewRegexSplit(regexp.MustCompile(strings.Join(seq, "|")), maxLen)
+}
+
+// Delimiter sets the retention of delimiters. DELIMITER_DROP is the default,
+// delimiters are skipped. DELIMITER_PRECEDING and DELIMITER_FOLLOWING
+// attach the delimiter to the preceding or following token. DELIMITER_TOKEN
+// emits the delimiter as own token.
+func (s *RegexSlicer) Delimiter(x Delimiter) {
+	s.confMode = x
+}
+
+// [bufio.SplitFunc] for delimiters.
+func (s *RegexSlicer) Split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	at, end, found, more := s.find(data, 0, atEOF)
+	if more {
+		return 0, nil, nil
+	}
+
+	// delimiter is leading the token
+	if found && at == 0 {
+		switch s.confMode {
+		case DELIMITER_TOKEN:
+			return end, data[:end], nil
+		case DELIMITER_FOLLOWING:
+			at, end, found, more = s.find(data, end, atEOF)
+			if more {
+				return 0, nil, nil
+			}
+		}
+	}
+
+	if found {
+		switch s.confMode {
+		case DELIMITER_PRECEDING:
+			return end, data[:end], nil
+		case DELIMITER_FOLLOWING, DELIMITER_TOKEN:
+			return at, data[:at], nil
+		default:
+			return end, data[:at], nil
+		}
+	}
+
+	if atEOF {
+		return len(data), data, nil
+	}
+
+	return 0, nil, nil
+}
+
+// the earliest non-empty delimiter at or after the offset
+func (s *RegexSlicer) find(data []byte, from int, atEOF bool) (at, end int, found, more bool) {
+	for from < len(data) {
+		loc := s.re.FindIndex(data[from:])
+		if loc == nil {
+			return 0, 0, false, !atEOF
+		}
+
+		at, end = from+loc[0], from+loc[1]
+		if !atEOF && at+s.maxLen >= len(data) {
+			// Request more data to stabilize the match.
+			return 0, 0, false, true
+		}
+
+		if at < end {
+			return at, end, true, false
+		}
+
+		_, n := utf8.DecodeRune(data[at:])
+		from = at + n
+	}
+
+	return 0, 0, false, !atEOF
+}
@@ -9,6 +9,7 @@
 package scanner_test
 
 import (
+	"regexp"
 	"strings"
 	"testing"
 
@@ -34,3 +35,60 @@ func TestSlicer(t *testing.T) {
 		)
 	}
 }
+
+func TestRegexSlicer(t *testing.T) {
+	for _, tt := range []struct {
+		mode     scanner.Delimiter
+		input    string
+		expected []string
+	}{
+		{scanner.DELIMITER_DROP, "a\n\n\nb\nc", []string{"a", "b", "c"}},
+		{scanner.DELIMITER_PRECEDING, "a\n\n\nb\nc", []string{"a\n\n\n", "b\n", "c"}},
+		{scanner.DELIMITER_FOLLOWING, "a\n\n\nb\nc", []string{"a", "\n\n\nb", "\nc"}},
+		{scanner.DELIMITER_TOKEN, "a\n\n\nb\nc", []string{"a", "\n\n\n", "b", "\n", "c"}},
+		{scanner.DELIMITER_TOKEN, "\na\n", []string{"\n", "a", "\n"}},
+	} {
+		split := scanner.NewRegexSplit(regexp.MustCompile(`\n+`), 8)
+		split.Delimiter(tt.mode)
+
+		s := scanner.NewSplitter(split.Split, strings.NewReader(tt.input))
+		s.Buffer(make([]byte, 2), 1024)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(tt.expected...),
+		)
+	}
+}
+
+func TestDelimitersSlicer(t *testing.T) {
+	for _, tt := range []struct {
+		mode     scanner.Delimiter
+		input    string
+		expected []string
+	}{
+		{scanner.DELIMITER_DROP, "a\r\nb\nc;d", []string{"a", "b", "c", "d"}},
+		{scanner.DELIMITER_PRECEDING, "a\r\nb\nc;d", []string{"a\r\n", "b\n", "c;", "d"}},
+		{scanner.DELIMITER_FOLLOWING, "a\r\nb\nc;d", []string{"a", "\r\nb", "\nc", ";d"}},
+		{scanner.DELIMITER_TOKEN, "a\r\nb;;c", []string{"a", "\r\n", "b", ";", ";", "c"}},
+	} {
+		split := scanner.NewDelimitersSplit("\n", "\r\n", ";")
+		split.Delimiter(tt.mode)
+
+		s := scanner.NewSplitter(split.Split, strings.NewReader(tt.input))
+		s.Buffer(make([]byte, 2), 1024)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal(tt.expected...),
+		)
+	}
+}
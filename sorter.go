@@ -10,7 +10,6 @@ package scanner
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/fogfish/golem/optics"
 	"github.com/fogfish/golem/trait/seq"
@@ -34,17 +33,23 @@ type Sorter[T any] struct {
 	confSimilarity        func([]float32, []float32) bool
 	confWindowInSentences int
 	confSimilarityWith    SimilarityWith
+	confConcurrency       int
+	confBudget            int
 	scanner               seq.Seq[T]
 	lens                  optics.Lens[T, string]
 	err                   error
+	usage                 Usage
 	eof                   bool
 	window                []typed[T]
 	cursor                []T
+	tokens                int
+	vectors               [][]float32
 }
 
 type typed[T any] struct {
 	object T
 	vector []float32
+	tokens int
 }
 
 // Creates new instance of semantic Sorter, seq.Seq[T] is source of records.
@@ -54,6 +59,7 @@ func NewSorter[T any](embed Embedder, lens optics.Lens[T, string], seq seq.Seq[T
 		confSimilarity:        HighSimilarity,
 		confWindowInSentences: 32,
 		confSimilarityWith:    SIMILARITY_WITH_TAIL,
+		confConcurrency:       1,
 		scanner:               seq,
 		lens:                  lens,
 		window:                make([]typed[T], 0),
@@ -78,6 +84,23 @@ func (s *Sorter[T]) SimilarityWith(x SimilarityWith) {
 	s.confSimilarityWith = x
 }
 
+// Concurrency defines the number of embedding requests in-flight while
+// filling the context window, the order of sentences is preserved.
+// The default value is 1, sentences are embedded sequentially.
+// It has no effect if the embedder embeds batches natively (e.g. the
+// provider's [BatchEmbedder]), it applies to [Cache] or [Retry] wrapping
+// a plain embedder.
+func (s *Sorter[T]) Concurrency(n int) {
+	s.confConcurrency = n
+}
+
+// Budget defines the maximum number of tokens used by the scan. The scan stops
+// with [BudgetExceededError] once the budget is exceeded.
+// The default value is 0, the budget is unlimited.
+func (s *Sorter[T]) Budget(tokens int) {
+	s.confBudget = tokens
+}
+
 // Widow defines the context window for similarity detection.
 // The default value is 32 sentences.
 func (s *Sorter[T]) Window(n int) {
@@ -87,15 +110,41 @@ func (s *Sorter[T]) Window(n int) {
 func (s *Sorter[T]) Err() error { return s.err }
 func (s *Sorter[T]) Value() []T { return s.cursor }
 
+// stops the source sequence created by the library (see [Source.Seq])
+func (s *Sorter[T]) release() { release(s.scanner) }
+
+// Usage returns tokens and calls used by embeddings since the beginning of scan.
+func (s *Sorter[T]) Usage() Usage { return s.usage }
+
+// ChunkTokens returns tokens used to embed elements of the current chunk.
+func (s *Sorter[T]) ChunkTokens() int { return s.tokens }
+
+// Vectors returns embedding vectors of elements of the current chunk,
+// in the same order as [Sorter.Value].
+func (s *Sorter[T]) Vectors() [][]float32 { return s.vectors }
+
+// Centroid returns the mean vector of the current chunk, optionally
+// normalized to the unit length.
+func (s *Sorter[T]) Centroid(normalize bool) []float32 {
+	return Centroid(s.vectors, normalize)
+}
+
 // Next advances the Sorter through context window, sequences will be available
-// through [Scanner.Text]. It returns false if there was I/O error or EOF is reached.
+// through [Sorter.Value]. It returns false if there was I/O error or EOF is reached.
 func (s *Sorter[T]) Next() bool {
+	return s.NextContext(context.Background())
+}
+
+// NextContext is like [Sorter.Next] but threads the context into every call
+// of embedding. It returns false if the context is cancelled, the context's
+// error is available through [Sorter.Err].
+func (s *Sorter[T]) NextContext(ctx context.Context) bool {
 	if s.err != nil {
 		return false
 	}
 
 	if !s.eof {
-		s.eof, s.err = s.fill()
+		s.eof, s.err = s.fill(ctx)
 		if s.err != nil {
 			return false
 		}
@@ -107,20 +156,35 @@ func (s *Sorter[T]) Next() bool {
 }
 
 // fill the window
-func (s *Sorter[T]) fill() (bool, error) {
+func (s *Sorter[T]) fill(ctx context.Context) (bool, error) {
 	wn := s.confWindowInSentences - len(s.window)
+	seq := make([]typed[T], 0, max(wn, 0))
+	text := make([]string, 0, max(wn, 0))
 
 	has := s.scanner != nil
 	for ; wn > 0 && has; has = s.scanner.Next() {
 		obj := s.scanner.Value()
-		txt := s.lens.Get(&obj)
-		v32, _, err := s.embed.Embedding(context.Background(), txt)
+		seq = append(seq, typed[T]{object: obj})
+		text = append(text, s.lens.Get(&obj))
+		wn--
+	}
+
+	if len(seq) > 0 {
+		vectors, used, calls, err := embeddings(ctx, s.embed, s.confConcurrency, text)
+		exceeded := s.usage.account(used, calls, s.confBudget)
 		if err != nil {
-			return false, fmt.Errorf("embedding has failed: %w, for {%s}", err, txt)
+			return false, err
 		}
 
-		s.window = append(s.window, typed[T]{object: obj, vector: v32})
-		wn--
+		if exceeded != nil {
+			return false, exceeded
+		}
+
+		for i := range seq {
+			seq[i].vector = vectors[i]
+			seq[i].tokens = used[i]
+		}
+		s.window = append(s.window, seq...)
 	}
 
 	return !has || wn != 0, nil
@@ -129,6 +193,8 @@ func (s *Sorter[T]) fill() (bool, error) {
 // peek similar from the window
 func (s *Sorter[T]) peek() []T {
 	if len(s.window) == 0 {
+		s.tokens = 0
+		s.vectors = nil
 		return nil
 	}
 
@@ -156,8 +222,12 @@ func (s *Sorter[T]) peek() []T {
 	s.window = b
 
 	seq := make([]T, len(a))
+	s.tokens = 0
+	s.vectors = make([][]float32, len(a))
 	for i, x := range a {
 		seq[i] = x.object
+		s.tokens += x.tokens
+		s.vectors[i] = x.vector
 	}
 	return seq
 }
@@ -9,6 +9,7 @@
 package scanner_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/fogfish/golem/optics"
@@ -44,3 +45,80 @@ func TestSorter(t *testing.T) {
 		it.True(s.Next()),
 	)
 }
+
+func TestSorterContext(t *testing.T) {
+	text := []obj{{"a."}, {"bb."}, {"c."}, {"ddd."}, {"ff."}}
+
+	s := scanner.NewSorter(embed{},
+		optics.ForProduct1[obj, string](),
+		seq.FromSlice(text),
+	)
+	s.Similarity(similar)
+	s.Window(3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it.Then(t).ShouldNot(
+		it.True(s.NextContext(ctx)),
+	).Should(
+		it.Fail(s.Err).Contain("context canceled"),
+	)
+}
+
+func TestSorterConcurrency(t *testing.T) {
+	text := []obj{{"a."}, {"bb."}, {"c."}, {"ddd."}, {"ff."}}
+
+	s := scanner.NewSorter(embed{},
+		optics.ForProduct1[obj, string](),
+		seq.FromSlice(text),
+	)
+	s.Similarity(similar)
+	s.Concurrency(4)
+	s.Window(5)
+
+	it.Then(t).Should(
+		it.True(s.Next()),
+		it.Seq(s.Value()).Equal(obj{"a."}, obj{"c."}),
+		it.True(s.Next()),
+		it.Seq(s.Value()).Equal(obj{"bb."}, obj{"ff."}),
+		it.True(s.Next()),
+		it.Seq(s.Value()).Equal(obj{"ddd."}),
+	)
+
+	it.Then(t).ShouldNot(
+		it.True(s.Next()),
+	)
+}
+
+func TestSorterUsage(t *testing.T) {
+	text := []obj{{"a1."}, {"b22."}, {"a3."}, {"b4."}}
+
+	s := scanner.NewSorter(topic{},
+		optics.ForProduct1[obj, string](),
+		seq.FromSlice(text),
+	)
+	s.Window(4)
+
+	it.Then(t).Should(
+		it.True(s.Next()),
+		it.Seq(s.Value()).Equal(obj{"a1."}, obj{"a3."}),
+		it.Equal(s.ChunkTokens(), 6),
+		it.Equal(s.Usage(), scanner.Usage{Tokens: 13, Calls: 4}),
+	)
+}
+
+func TestSorterVectors(t *testing.T) {
+	text := []obj{{"a1."}, {"b2."}, {"a3."}}
+
+	s := scanner.NewSorter(topic{},
+		optics.ForProduct1[obj, string](),
+		seq.FromSlice(text),
+	)
+
+	it.Then(t).Should(
+		it.True(s.Next()),
+		it.Equal(len(s.Vectors()), 2),
+		it.Seq(s.Centroid(false)).Equal(1, 0, 0, 0),
+	)
+}
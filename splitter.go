@@ -0,0 +1,228 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Splitter is [bufio.Scanner] that annotates tokens with position at
+// the source document. Use [Splitter.Chunk] to get the position of
+// the current token.
+type Splitter struct {
+	*bufio.Scanner
+	split        bufio.SplitFunc
+	pos          Position
+	para         paragraphs
+	span         Span
+	seq          int
+	gap          []byte
+	sep          string
+	maxSize      int
+	confOversize Oversize
+	skipping     bool
+	skipped      []Span
+	tail         []byte
+}
+
+// Configure handling of tokens exceeding the max token size
+type Oversize int
+
+// Configure handling of tokens exceeding the max token size
+const (
+	OVERSIZE_FAIL Oversize = iota
+	OVERSIZE_SPLIT
+	OVERSIZE_SKIP
+)
+
+// Create a scanner that slices input stream using split function
+func NewSplitter(split bufio.SplitFunc, r io.Reader) *Splitter {
+	s := &Splitter{
+		Scanner: bufio.NewScanner(r),
+		split:   split,
+		pos:     origin,
+		seq:     -1,
+		maxSize: bufio.MaxScanTokenSize,
+	}
+	s.Scanner.Split(s.track)
+	return s
+}
+
+// Buffer sets the initial buffer and the maximum size of buffer that may be
+// allocated during scanning, see [bufio.Scanner.Buffer]. It panics if it is
+// called after scanning has started.
+func (s *Splitter) Buffer(buf []byte, size int) {
+	s.Scanner.Buffer(buf, size)
+	s.maxSize = max(size, cap(buf))
+}
+
+// MaxTokenSize sets the maximum size of token in bytes, the default value
+// is [bufio.MaxScanTokenSize]. It panics if it is called after scanning
+// has started.
+func (s *Splitter) MaxTokenSize(n int) {
+	s.Buffer(nil, n)
+}
+
+// Oversize sets the handling of tokens exceeding the max token size.
+//
+// Using OVERSIZE_FAIL stops the scan with [bufio.ErrTooLong], it is
+// the default behavior.
+//
+// Using OVERSIZE_SPLIT forces the split at the last whitespace within
+// the limit, or at the rune boundary if there are no whitespaces.
+//
+// Using OVERSIZE_SKIP skips the token up to the next token boundary detected
+// by the split function. Spans of skipped tokens are reported by [Splitter.Skipped].
+func (s *Splitter) Oversize(x Oversize) {
+	s.confOversize = x
+}
+
+// Skipped returns spans of oversize tokens skipped since the beginning of scan.
+func (s *Splitter) Skipped() []Span { return s.skipped }
+
+// Chunk returns the most recent token generated by a call to Scan,
+// annotated with position.
+func (s *Splitter) Chunk() Chunk {
+	return Chunk{
+		Span:      s.span,
+		Text:      s.Text(),
+		Spans:     []Span{s.span},
+		Sentences: []int{s.seq},
+	}
+}
+
+// Separator returns bytes of the source between the preceding token and
+// the most recent one, e.g. delimiter or whitespaces skipped by split function.
+// It is empty for the first token unless the input starts with skipped bytes.
+func (s *Splitter) Separator() string { return s.sep }
+
+// [bufio.SplitFunc] that tracks position of tokens
+func (s *Splitter) track(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	advance, token, err = s.split(data, atEOF)
+
+	// the buffer is full, the scanner fails unless the token is cut
+	if err == nil && advance == 0 && token == nil && !atEOF && len(data) >= s.maxSize {
+		switch s.confOversize {
+		case OVERSIZE_SPLIT:
+			advance, token = oversize(data)
+		case OVERSIZE_SKIP:
+			if !s.skipping || s.ended(data) {
+				s.skipping = true
+				s.skipped = append(s.skipped, Span{Start: s.pos})
+			}
+			s.pos = forward(s.pos, data)
+			s.pos.Paragraph = s.para.forward(data)
+			s.skipped[len(s.skipped)-1].End = s.pos
+			s.tail = append(s.tail[:0], data[max(len(data)-tailSize, 0):]...)
+			return len(data), nil, nil
+		}
+	}
+
+	// the remainder of skipped token, unless the skipped token ends at
+	// the buffer boundary and the token is the next one
+	if s.skipping && token != nil {
+		s.skipping = false
+		if !s.ended(data) {
+			end := offsetOf(data, token) + len(token)
+			s.skipped[len(s.skipped)-1].End = forward(s.pos, data[:end])
+			s.pos = forward(s.pos, data[:advance])
+			s.pos.Paragraph = s.para.forward(data[:advance])
+			return advance, nil, err
+		}
+	}
+
+	if advance <= 0 && token == nil {
+		return
+	}
+
+	if token == nil {
+		s.pos = forward(s.pos, data[:advance])
+		s.pos.Paragraph = s.para.forward(data[:advance])
+		s.gap = append(s.gap, data[:advance]...)
+		return
+	}
+
+	at := offsetOf(data, token)
+	start := forward(s.pos, data[:at])
+	start.Paragraph = s.para.forward(data[:at])
+	end := forward(start, token)
+	end.Paragraph = s.para.forward(token)
+
+	s.span = Span{Start: start, End: end}
+	s.seq++
+	s.sep = string(append(s.gap, data[:at]...))
+	s.gap = s.gap[:0]
+
+	if tail := at + len(token); tail <= advance {
+		s.pos = forward(end, data[tail:advance])
+		s.pos.Paragraph = s.para.forward(data[tail:advance])
+		s.gap = append(s.gap, data[tail:advance]...)
+	} else {
+		s.pos = forward(s.pos, data[:advance])
+		s.pos.Paragraph = end.Paragraph
+	}
+
+	return
+}
+
+// The number of bytes of skipped token used to detect its end
+const tailSize = 64
+
+// the skipped token ends at the buffer boundary, the split function
+// finds the token end within the tail of skipped bytes
+func (s *Splitter) ended(data []byte) bool {
+	buf := append(append(make([]byte, 0, len(s.tail)+len(data)), s.tail...), data...)
+	_, token, err := s.split(buf, false)
+	return err == nil && token != nil && offsetOf(buf, token)+len(token) <= len(s.tail)
+}
+
+// cut oversize data at the last whitespace or at the rune boundary
+func oversize(data []byte) (advance int, token []byte) {
+	if at := bytes.LastIndexFunc(data, unicode.IsSpace); at > 0 {
+		if token := bytes.TrimSpace(data[:at]); len(token) > 0 {
+			_, n := utf8.DecodeRune(data[at:])
+			return at + n, token
+		}
+	}
+
+	at := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				at = i
+			}
+			break
+		}
+	}
+
+	if at == 0 {
+		at = len(data)
+	}
+
+	return at, data[:at]
+}
+
+// offset of token within data
+func offsetOf(data, token []byte) int {
+	at := cap(data) - cap(token)
+	if at >= 0 && at+len(token) <= len(data) &&
+		(len(token) == 0 || &data[at] == &token[0]) {
+		return at
+	}
+
+	if at = bytes.Index(data, token); at >= 0 {
+		return at
+	}
+
+	return 0
+}
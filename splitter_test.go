@@ -0,0 +1,156 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestSplitterChunk(t *testing.T) {
+	text := "Hello! Wörld.\n  Next line."
+	s := scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(text))
+
+	seq := make([]scanner.Chunk, 0)
+	for s.Scan() {
+		seq = append(seq, s.Chunk())
+	}
+
+	it.Then(t).Should(
+		it.Equal(len(seq), 3),
+
+		it.Equal(seq[0].Text, "Hello!"),
+		it.Equal(seq[0].Start, scanner.Position{Offset: 0, Rune: 0, Line: 1, Column: 1}),
+		it.Equal(seq[0].End, scanner.Position{Offset: 6, Rune: 6, Line: 1, Column: 7}),
+		it.Seq(seq[0].Sentences).Equal(0),
+
+		it.Equal(seq[1].Text, "Wörld."),
+		it.Equal(seq[1].Start, scanner.Position{Offset: 7, Rune: 7, Line: 1, Column: 8}),
+		it.Equal(seq[1].End, scanner.Position{Offset: 14, Rune: 13, Line: 1, Column: 14}),
+		it.Seq(seq[1].Sentences).Equal(1),
+
+		it.Equal(seq[2].Text, "Next line."),
+		it.Equal(seq[2].Start, scanner.Position{Offset: 17, Rune: 16, Line: 2, Column: 3}),
+		it.Equal(seq[2].End, scanner.Position{Offset: 27, Rune: 26, Line: 2, Column: 13}),
+		it.Seq(seq[2].Sentences).Equal(2),
+	)
+
+	for _, c := range seq {
+		it.Then(t).Should(
+			it.Equal(text[c.Start.Offset:c.End.Offset], c.Text),
+		)
+	}
+}
+
+func TestSlicerChunk(t *testing.T) {
+	text := "Hello!!World!!3.14"
+	s := scanner.NewSlices("!!", strings.NewReader(text))
+
+	for s.Scan() {
+		c := s.Chunk()
+		it.Then(t).Should(
+			it.Equal(text[c.Start.Offset:c.End.Offset], c.Text),
+		)
+	}
+}
+
+func TestSplitterOversize(t *testing.T) {
+	text := "Hi. The quick brown fox jumps over the lazy dog. Привет, мирмирмир. Ok."
+
+	t.Run("Fail", func(t *testing.T) {
+		s := scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(text))
+		s.MaxTokenSize(16)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		it.Then(t).Should(
+			it.Seq(seq).Equal("Hi."),
+			it.Equal(s.Err(), bufio.ErrTooLong),
+		)
+	})
+
+	t.Run("Split", func(t *testing.T) {
+		s := scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(text))
+		s.MaxTokenSize(16)
+		s.Oversize(scanner.OVERSIZE_SPLIT)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+			it.Then(t).Should(
+				it.Equal(s.Chunk().End.Offset-s.Chunk().Start.Offset, len(s.Text())),
+				it.Equal(text[s.Chunk().Start.Offset:s.Chunk().End.Offset], s.Text()),
+			)
+		}
+
+		it.Then(t).Should(
+			it.Nil(s.Err()),
+			it.Seq(seq).Equal(
+				"Hi.", "The quick", "brown fox jumps", "over the lazy", "dog.",
+				"Привет,", "мирмирми", "р.", "Ok.",
+			),
+		)
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		s := scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(text))
+		s.MaxTokenSize(16)
+		s.Oversize(scanner.OVERSIZE_SKIP)
+
+		seq := make([]string, 0)
+		for s.Scan() {
+			seq = append(seq, s.Text())
+		}
+
+		skipped := s.Skipped()
+		it.Then(t).Should(
+			it.Nil(s.Err()),
+			it.Seq(seq).Equal("Hi.", "Ok."),
+			it.Equal(len(skipped), 2),
+			it.Equal(text[skipped[0].Start.Offset:skipped[0].End.Offset], " The quick brown fox jumps over the lazy dog."),
+		)
+
+		// the skipped token ends at the buffer boundary
+		for _, tt := range []struct {
+			input   string
+			seq     []string
+			skipped []string
+		}{
+			{"aaaaaaa. Next. Last.", []string{"Next.", "Last."}, []string{"aaaaaaa."}},
+			{"aaaaaaa. Next one. Last.", []string{"Last."}, []string{"aaaaaaa.", " Next one."}},
+		} {
+			s := scanner.NewSentences(scanner.EndOfSentence, strings.NewReader(tt.input))
+			s.Buffer(make([]byte, 8), 8)
+			s.Oversize(scanner.OVERSIZE_SKIP)
+
+			seq := make([]string, 0)
+			for s.Scan() {
+				seq = append(seq, s.Text())
+			}
+
+			skipped := make([]string, 0)
+			for _, x := range s.Skipped() {
+				skipped = append(skipped, tt.input[x.Start.Offset:x.End.Offset])
+			}
+
+			it.Then(t).Should(
+				it.Nil(s.Err()),
+				it.Seq(seq).Equal(tt.seq...),
+				it.Seq(skipped).Equal(tt.skipped...),
+			)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import (
+	"unicode"
+)
+
+// Tokenizer measures the length of text in tokens.
+type Tokenizer interface {
+	Count(text string) int
+}
+
+// Approx is tokenizer that estimates number of tokens. Each punctuation or
+// symbol rune is a token, each word is split into tokens of CharsPerToken
+// runes. Whitespaces are not counted.
+type Approx struct {
+	CharsPerToken int
+}
+
+var _ Tokenizer = Approx{}
+
+// Creates approximate tokenizer, 4 characters per token is a good estimate
+// for English texts.
+func NewApprox(charsPerToken int) Approx {
+	return Approx{CharsPerToken: max(charsPerToken, 1)}
+}
+
+func (t Approx) Count(text string) int {
+	cpt := max(t.CharsPerToken, 1)
+	tokens, word := 0, 0
+
+	for _, r := range text {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+			word++
+			continue
+		case unicode.IsSpace(r):
+		default:
+			tokens++
+		}
+
+		tokens += (word + cpt - 1) / cpt
+		word = 0
+	}
+
+	return tokens + (word+cpt-1)/cpt
+}
@@ -0,0 +1,30 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner_test
+
+import (
+	"testing"
+
+	"github.com/fogfish/it/v2"
+	"github.com/fogfish/scanner"
+)
+
+func TestApprox(t *testing.T) {
+	for input, expected := range map[string]int{
+		"":                      0,
+		"Hello":                 2,
+		"Hello World!":          5,
+		"a, b; c.":              6,
+		"Tokenization   works.": 6,
+	} {
+		it.Then(t).Should(
+			it.Equal(scanner.NewApprox(4).Count(input), expected),
+		)
+	}
+}
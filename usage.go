@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2025 Dmitry Kolesnikov
+//
+// This file may be modified and distributed under the terms
+// of the MIT license.  See the LICENSE file for details.
+// https://github.com/fogfish/scanner
+//
+
+package scanner
+
+import "fmt"
+
+// Usage of embedding provider across the scan.
+type Usage struct {
+	Tokens int // total tokens used by embeddings
+	Calls  int // number of embedding calls
+}
+
+// BudgetExceededError is returned when the scan uses more tokens than
+// the budget allows.
+type BudgetExceededError struct {
+	Budget int
+	Used   int
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("token budget exceeded: used %d of %d", e.Used, e.Budget)
+}
+
+// account usage of embeddings, fails if the budget is exceeded
+func (u *Usage) account(used []int, calls int, budget int) error {
+	u.Tokens += sum(used)
+	u.Calls += calls
+
+	if budget > 0 && u.Tokens > budget {
+		return &BudgetExceededError{Budget: budget, Used: u.Tokens}
+	}
+
+	return nil
+}